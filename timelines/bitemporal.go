@@ -0,0 +1,148 @@
+package timelines
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// BitemporalValue is a value valid during Valid period, and believed during Recorded period (transaction time).
+type BitemporalValue[T any] struct {
+	Valid    Period
+	Recorded Period
+	Value    T
+}
+
+// IsCurrent checks if the value is still believed.
+func (v *BitemporalValue[T]) IsCurrent() bool {
+	return v.Recorded.HasInfiniteEnd()
+}
+
+// BitemporalTimeline records values with both valid time and transaction time.
+// Corrections supersede previous beliefs without deleting them, so that past knowledge can be queried.
+type BitemporalTimeline[T any] struct {
+	records    []BitemporalValue[T]
+	lastRecord time.Time
+}
+
+// NewBitemporalTimeline creates and returns an empty BitemporalTimeline.
+func NewBitemporalTimeline[T any]() *BitemporalTimeline[T] {
+	return &BitemporalTimeline[T]{records: []BitemporalValue[T]{}}
+}
+
+// Record asserts that value is valid during period, as known from recordedAt.
+// Current beliefs overlapping period stop being believed at recordedAt, their parts outside period being recorded again.
+// Recording times must be chronological.
+func (b *BitemporalTimeline[T]) Record(period Period, value T, recordedAt time.Time) error {
+	if err := b.supersede(period, recordedAt); err != nil {
+		return err
+	}
+
+	b.records = append(b.records, BitemporalValue[T]{
+		Valid:    period,
+		Recorded: Period{Start: recordedAt, End: PositiveInfinity},
+		Value:    value,
+	})
+	return nil
+}
+
+// Retract asserts that nothing is known about period from recordedAt.
+// Recording times must be chronological.
+func (b *BitemporalTimeline[T]) Retract(period Period, recordedAt time.Time) error {
+	return b.supersede(period, recordedAt)
+}
+
+func (b *BitemporalTimeline[T]) supersede(period Period, recordedAt time.Time) error {
+	if period.IsEmpty() {
+		return errors.New("end date must be after start date")
+	}
+	if recordedAt.Before(b.lastRecord) {
+		return errors.New("recording times should be chronological")
+	}
+	b.lastRecord = recordedAt
+
+	var remnants []BitemporalValue[T]
+	for i := range b.records {
+		record := &b.records[i]
+		if !record.IsCurrent() || !record.Valid.Intersects(period) {
+			continue
+		}
+
+		record.Recorded.End = recordedAt
+		for part := range record.Valid.SplitFromPeriodSeq(period) {
+			if !part.Intersects(period) {
+				remnants = append(remnants, BitemporalValue[T]{
+					Valid:    part,
+					Recorded: Period{Start: recordedAt, End: PositiveInfinity},
+					Value:    record.Value,
+				})
+			}
+		}
+	}
+
+	// a belief superseded at the time it was recorded has never been believed
+	b.records = slices.DeleteFunc(b.records, func(record BitemporalValue[T]) bool {
+		return record.Recorded.IsEmpty()
+	})
+	b.records = append(b.records, remnants...)
+
+	return nil
+}
+
+// AsOf returns the Timeline believed at given knowledge time.
+func (b *BitemporalTimeline[T]) AsOf(knowledgeTime time.Time) Timeline[T] {
+	return b.timeline(func(record *BitemporalValue[T]) bool {
+		return record.Recorded.Contains(knowledgeTime)
+	})
+}
+
+// Current returns the Timeline currently believed.
+func (b *BitemporalTimeline[T]) Current() Timeline[T] {
+	return b.timeline((*BitemporalValue[T]).IsCurrent)
+}
+
+func (b *BitemporalTimeline[T]) timeline(believed func(record *BitemporalValue[T]) bool) Timeline[T] {
+	t := NewTimeline[T]()
+	for i := range b.records {
+		if believed(&b.records[i]) {
+			t.Items = append(t.Items, NewPeriodValue(b.records[i].Valid, b.records[i].Value))
+		}
+	}
+	t.SortTimelineByPeriodStart()
+
+	return t
+}
+
+// History returns every record, current or superseded, whose valid period intersects given period,
+// ordered by recording time then by valid period start.
+func (b *BitemporalTimeline[T]) History(valid Period) []BitemporalValue[T] {
+	return b.audit(func(record *BitemporalValue[T]) bool {
+		return record.Valid.Intersects(valid)
+	})
+}
+
+// RecordedDuring returns records asserted or superseded during given transaction time period,
+// ordered by recording time then by valid period start.
+func (b *BitemporalTimeline[T]) RecordedDuring(transaction Period) []BitemporalValue[T] {
+	return b.audit(func(record *BitemporalValue[T]) bool {
+		return transaction.Contains(record.Recorded.Start) || (!record.IsCurrent() && transaction.Contains(record.Recorded.End))
+	})
+}
+
+func (b *BitemporalTimeline[T]) audit(keep func(record *BitemporalValue[T]) bool) []BitemporalValue[T] {
+	var records []BitemporalValue[T]
+	for i := range b.records {
+		if keep(&b.records[i]) {
+			records = append(records, b.records[i])
+		}
+	}
+
+	slices.SortStableFunc(records, func(a, b BitemporalValue[T]) int {
+		if c := a.Recorded.Start.Compare(b.Recorded.Start); c != 0 {
+			return c
+		}
+		return a.Valid.Start.Compare(b.Valid.Start)
+	})
+
+	return records
+}
@@ -0,0 +1,88 @@
+package timelines
+
+import (
+	"testing"
+)
+
+func TestBitemporalTimeline_AsOf_ShouldReturnPastBeliefs(t *testing.T) {
+	rates := NewBitemporalTimeline[int]()
+	january, _ := Month(2024, 1)
+
+	if err := rates.Record(*january, 100, DateOnly(2024, 1, 5)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	correction := Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 1)}
+	if err := rates.Record(correction, 120, DateOnly(2024, 3, 10)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	before := rates.AsOf(DateOnly(2024, 3, 1))
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: *january, Value: 100},
+	}, before.Items)
+
+	after := rates.AsOf(DateOnly(2024, 3, 11))
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 15)}, Value: 100},
+		{Period: correction, Value: 120},
+	}, after.Items)
+
+	current := rates.Current()
+	assertPeriodValues(t, after.Items, current.Items)
+
+	if unknown := rates.AsOf(DateOnly(2024, 1, 1)); len(unknown.Items) != 0 {
+		t.Errorf("Expected nothing known before first record, got %v", unknown.Items)
+	}
+}
+
+func TestBitemporalTimeline_History_ShouldKeepSupersededRecords(t *testing.T) {
+	rates := NewBitemporalTimeline[int]()
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	_ = rates.Record(*january, 100, DateOnly(2024, 1, 5))
+	_ = rates.Record(*february, 200, DateOnly(2024, 1, 6))
+	_ = rates.Record(*january, 110, DateOnly(2024, 2, 1))
+	_ = rates.Retract(*january, DateOnly(2024, 3, 1))
+
+	history := rates.History(*january)
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 records, got %d: %v", len(history), history)
+	}
+
+	if history[0].Value != 100 || !history[0].Recorded.Equal(Period{Start: DateOnly(2024, 1, 5), End: DateOnly(2024, 2, 1)}) {
+		t.Errorf("Unexpected first record %v", history[0])
+	}
+
+	if history[1].Value != 110 || history[1].IsCurrent() || !history[1].Recorded.End.Equal(DateOnly(2024, 3, 1)) {
+		t.Errorf("Unexpected second record %v", history[1])
+	}
+
+	current := rates.Current()
+	assertPeriodValues(t, []PeriodValue[int]{{Period: *february, Value: 200}}, current.Items)
+
+	changes := rates.RecordedDuring(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 2)})
+	if len(changes) != 2 {
+		t.Errorf("Expected superseded and new records, got %v", changes)
+	}
+}
+
+func TestBitemporalTimeline_Record_ShouldBeChronological(t *testing.T) {
+	rates := NewBitemporalTimeline[int]()
+	january, _ := Month(2024, 1)
+
+	_ = rates.Record(*january, 100, DateOnly(2024, 2, 1))
+	if err := rates.Record(*january, 90, DateOnly(2024, 1, 1)); err == nil {
+		t.Errorf("Expected an error when recording in the past")
+	}
+
+	// a correction recorded at the same time replaces the belief
+	if err := rates.Record(*january, 110, DateOnly(2024, 2, 1)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if history := rates.History(*january); len(history) != 1 || history[0].Value != 110 {
+		t.Errorf("Expected only last belief to be kept, got %v", history)
+	}
+}
@@ -0,0 +1,87 @@
+package timelines
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConcurrentTimeline is a Timeline safe for concurrent use. Writers work on a copy of the items which is then
+// published atomically, so readers never block and always see a consistent version.
+type ConcurrentTimeline[T any] struct {
+	// mu serializes writers
+	mu      sync.Mutex
+	current atomic.Pointer[Timeline[T]]
+}
+
+// NewConcurrentTimeline creates a ConcurrentTimeline holding a copy of the items of given Timeline.
+func NewConcurrentTimeline[T any](t Timeline[T]) *ConcurrentTimeline[T] {
+	c := &ConcurrentTimeline[T]{}
+	c.current.Store(&Timeline[T]{Items: slices.Clone(t.Items)})
+	return c
+}
+
+// load returns the current version, which must not be modified.
+func (c *ConcurrentTimeline[T]) load() *Timeline[T] {
+	if t := c.current.Load(); t != nil {
+		return t
+	}
+	return &Timeline[T]{}
+}
+
+// Update applies f to a copy of the current version, then publishes it if f does not return an error,
+// so that readers see either none or all of the changes made by f.
+func (c *ConcurrentTimeline[T]) Update(f func(t *Timeline[T]) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	next := &Timeline[T]{Items: slices.Clone(c.load().Items)}
+	if err := f(next); err != nil {
+		return err
+	}
+
+	c.current.Store(next)
+	return nil
+}
+
+// Add adds a new PeriodValue to the ConcurrentTimeline.
+func (c *ConcurrentTimeline[T]) Add(newPeriod Period, newValue T) {
+	_ = c.Update(func(t *Timeline[T]) error {
+		t.Add(newPeriod, newValue)
+		return nil
+	})
+}
+
+// Snapshot returns a copy of the current version.
+func (c *ConcurrentTimeline[T]) Snapshot() Timeline[T] {
+	return Timeline[T]{Items: slices.Clone(c.load().Items)}
+}
+
+// All returns an iterator over the items of the current version, which is not affected by later updates.
+func (c *ConcurrentTimeline[T]) All() iter.Seq[PeriodValue[T]] {
+	t := c.load()
+	return func(yield func(PeriodValue[T]) bool) {
+		for _, pv := range t.Items {
+			if !yield(pv) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of items of the current version.
+func (c *ConcurrentTimeline[T]) Len() int {
+	return len(c.load().Items)
+}
+
+// FindIntersects returns items of the current version overlapping given period.
+func (c *ConcurrentTimeline[T]) FindIntersects(period Period) []PeriodValue[T] {
+	return c.load().FindIntersects(period)
+}
+
+// ValuesAt returns values of all items of the current version covering given instant.
+func (c *ConcurrentTimeline[T]) ValuesAt(instant time.Time) []T {
+	return c.load().ValuesAt(instant)
+}
@@ -0,0 +1,88 @@
+package timelines
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConcurrentTimeline_ShouldSupportConcurrentReadersAndWriters(t *testing.T) {
+	c := NewConcurrentTimeline(NewTimeline[int]())
+	origin := DateOnly(2024, 1, 1)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				start := origin.Add(time.Duration(w*100+i) * time.Hour)
+				c.Add(Period{Start: start, End: start.Add(time.Hour)}, w)
+			}
+		}(w)
+	}
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				var previous time.Time
+				for pv := range c.All() {
+					if pv.Period.Start.Before(previous) {
+						t.Errorf("Expected a sorted snapshot")
+					}
+					previous = pv.Period.Start
+				}
+				_ = c.ValuesAt(origin.Add(time.Duration(i) * time.Hour))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if c.Len() != 400 {
+		t.Errorf("Expected 400 items, got %d", c.Len())
+	}
+}
+
+func TestConcurrentTimeline_Update_ShouldBeAtomic(t *testing.T) {
+	c := NewConcurrentTimeline(NewTimeline[int]())
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	err := c.Update(func(t *Timeline[int]) error {
+		t.Add(*january, 1)
+		return errors.New("rollback")
+	})
+	if err == nil || c.Len() != 0 {
+		t.Fatalf("Expected failed update to be discarded, got %d items", c.Len())
+	}
+
+	snapshot := c.Snapshot()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = c.Update(func(t *Timeline[int]) error {
+			t.Add(*january, 1)
+			t.Add(*february, 2)
+			return nil
+		})
+	}()
+
+	// readers see either none or both items
+	for {
+		if n := c.Len(); n != 0 && n != 2 {
+			t.Fatalf("Expected 0 or 2 items, got %d", n)
+		}
+		select {
+		case <-done:
+			if c.Len() != 2 || len(snapshot.Items) != 0 {
+				t.Errorf("Expected snapshot to be unchanged and timeline to have 2 items")
+			}
+			return
+		default:
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package timelines
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ConflictStrategy decides which of two overlapping items wins on their common period,
+// returning true to keep the incoming one, or an error to reject the conflict.
+type ConflictStrategy[T any] func(existing PeriodValue[T], incoming PeriodValue[T]) (bool, error)
+
+// LastWins returns a ConflictStrategy keeping the item inserted last.
+func LastWins[T any]() ConflictStrategy[T] {
+	return func(existing PeriodValue[T], incoming PeriodValue[T]) (bool, error) {
+		return true, nil
+	}
+}
+
+// FirstWins returns a ConflictStrategy keeping the item inserted first.
+func FirstWins[T any]() ConflictStrategy[T] {
+	return func(existing PeriodValue[T], incoming PeriodValue[T]) (bool, error) {
+		return false, nil
+	}
+}
+
+// ByPriority returns a ConflictStrategy keeping the item having the highest priority, the last inserted one on ties.
+func ByPriority[T any](priority func(value T) int) ConflictStrategy[T] {
+	return func(existing PeriodValue[T], incoming PeriodValue[T]) (bool, error) {
+		return priority(incoming.Value) >= priority(existing.Value), nil
+	}
+}
+
+// ShortestWins returns a ConflictStrategy keeping the item having the shortest period, the last inserted one on ties.
+func ShortestWins[T any]() ConflictStrategy[T] {
+	return func(existing PeriodValue[T], incoming PeriodValue[T]) (bool, error) {
+		return incoming.Period.Duration() <= existing.Period.Duration(), nil
+	}
+}
+
+// Reject returns a ConflictStrategy failing on any overlap.
+func Reject[T any]() ConflictStrategy[T] {
+	return func(existing PeriodValue[T], incoming PeriodValue[T]) (bool, error) {
+		return false, fmt.Errorf("period %v overlaps period %v", incoming.Period, existing.Period)
+	}
+}
+
+// ranked is a value remembering its insertion rank.
+type ranked[T any] struct {
+	value T
+	rank  int
+}
+
+// resolveWithStrategy returns non-overlapping sorted items where each period holds the value of the item
+// winning among items covering it, items being given in insertion order.
+func resolveWithStrategy[T any](items []PeriodValue[T], strategy ConflictStrategy[T]) ([]PeriodValue[T], error) {
+	ranks := make([]PeriodValue[ranked[T]], 0, len(items))
+	for i, pv := range items {
+		ranks = append(ranks, NewPeriodValue(pv.Period, ranked[T]{value: pv.Value, rank: i}))
+	}
+	sortStableByPeriodStart(ranks)
+
+	result := []PeriodValue[T]{}
+	var winners []int
+	var conflict error
+	var candidates []PeriodValue[ranked[T]]
+
+	err := sweep(pullSorted(ranks), func(period Period, active []PeriodValue[ranked[T]]) {
+		if conflict != nil {
+			return
+		}
+
+		candidates = append(candidates[:0], active...)
+		slices.SortFunc(candidates, func(a, b PeriodValue[ranked[T]]) int { return a.Value.rank - b.Value.rank })
+
+		winner := candidates[0]
+		for _, incoming := range candidates[1:] {
+			keepIncoming, err := strategy(items[winner.Value.rank], items[incoming.Value.rank])
+			if err != nil {
+				conflict = err
+				return
+			}
+			if keepIncoming {
+				winner = incoming
+			}
+		}
+
+		// extend the previous slice when it has been won by the same item
+		last := len(result) - 1
+		if last >= 0 && winners[last] == winner.Value.rank && result[last].Period.End.Equal(period.Start) {
+			result[last].Period.End = period.End
+			return
+		}
+		result = append(result, NewPeriodValue(period, winner.Value.value))
+		winners = append(winners, winner.Value.rank)
+	})
+	if err == nil {
+		err = conflict
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AddWithStrategy adds a new PeriodValue to the Timeline, strategy deciding which of the new item and
+// each existing item overlapping it is kept on their common period. Other items are left as is,
+// so overlaps between existing items remain. Timeline items must be sorted.
+// On error, the Timeline is left unchanged.
+func (t *Timeline[T]) AddWithStrategy(newPeriod Period, newValue T, strategy ConflictStrategy[T]) error {
+	if newPeriod.IsEmpty() {
+		return nil
+	}
+	incoming := NewPeriodValue(newPeriod, newValue)
+
+	// items are sorted, so only items starting before the new period ends may overlap it
+	first := sort.Search(len(t.Items), func(i int) bool {
+		return t.Items[i].Period.Start.After(newPeriod.Start)
+	})
+	end := sort.Search(len(t.Items), func(i int) bool {
+		return !t.Items[i].Period.Start.Before(newPeriod.End)
+	})
+
+	kept := []Period{newPeriod}
+	lost := make(map[int]bool)
+	for i, pv := range t.Items[:end] {
+		if !pv.Period.Intersects(newPeriod) {
+			continue
+		}
+
+		keepIncoming, err := strategy(pv, incoming)
+		if err != nil {
+			return err
+		}
+		if keepIncoming {
+			lost[i] = true
+		} else {
+			kept = cutPeriods(kept, pv.Period)
+		}
+		first = min(first, i)
+	}
+
+	replacement := make([]PeriodValue[T], 0, end-first+len(kept)+len(lost))
+	for i, pv := range t.Items[first:end] {
+		if !lost[first+i] {
+			replacement = append(replacement, pv)
+			continue
+		}
+
+		parts := []Period{pv.Period}
+		for _, period := range kept {
+			parts = cutPeriods(parts, period)
+		}
+		for _, part := range parts {
+			replacement = append(replacement, NewPeriodValue(part, pv.Value))
+		}
+	}
+	for _, period := range kept {
+		replacement = append(replacement, NewPeriodValue(period, newValue))
+	}
+	sortStableByPeriodStart(replacement)
+
+	t.Items = slices.Replace(t.Items, first, end, replacement...)
+	return nil
+}
+
+// cutPeriods returns the parts of periods not covered by cut.
+func cutPeriods(periods []Period, cut Period) []Period {
+	result := make([]Period, 0, len(periods)+1)
+	for _, period := range periods {
+		if !period.Intersects(cut) {
+			result = append(result, period)
+			continue
+		}
+		if period.Start.Before(cut.Start) {
+			result = append(result, Period{Start: period.Start, End: cut.Start})
+		}
+		if period.End.After(cut.End) {
+			result = append(result, Period{Start: cut.End, End: period.End})
+		}
+	}
+	return result
+}
+
+// AggregateWithStrategy returns another timeline merging both timelines and resolving overlaps with strategy,
+// items of current timeline being considered inserted before items of the other one.
+func (t *Timeline[T]) AggregateWithStrategy(other *Timeline[T], strategy ConflictStrategy[T]) (Timeline[T], error) {
+	items := make([]PeriodValue[T], 0, len(t.Items)+len(other.Items))
+	items = append(items, t.Items...)
+	items = append(items, other.Items...)
+
+	resolved, err := resolveWithStrategy(items, strategy)
+	if err != nil {
+		return Timeline[T]{}, err
+	}
+
+	return Timeline[T]{Items: resolved}, nil
+}
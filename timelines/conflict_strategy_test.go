@@ -0,0 +1,171 @@
+package timelines
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTimeline_AggregateWithStrategy(t *testing.T) {
+	base, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 31), 1).
+		Build()
+	other, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 10), DateOnly(2024, 1, 20), 2).
+		Build()
+
+	tests := []struct {
+		name     string
+		strategy ConflictStrategy[int]
+		expected []PeriodValue[int]
+	}{
+		{
+			name:     "last wins",
+			strategy: LastWins[int](),
+			expected: []PeriodValue[int]{
+				{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, Value: 1},
+				{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}, Value: 2},
+				{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 31)}, Value: 1},
+			},
+		},
+		{
+			name:     "first wins",
+			strategy: FirstWins[int](),
+			expected: []PeriodValue[int]{
+				{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 31)}, Value: 1},
+			},
+		},
+		{
+			name:     "by priority",
+			strategy: ByPriority(func(v int) int { return -v }),
+			expected: []PeriodValue[int]{
+				{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 31)}, Value: 1},
+			},
+		},
+		{
+			name:     "shortest wins",
+			strategy: ShortestWins[int](),
+			expected: []PeriodValue[int]{
+				{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, Value: 1},
+				{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}, Value: 2},
+				{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 31)}, Value: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := base.AggregateWithStrategy(&other, tt.strategy)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			assertPeriodValues(t, tt.expected, result.Items)
+		})
+	}
+}
+
+func TestTimeline_AddWithStrategy_Reject(t *testing.T) {
+	timeline := NewTimeline[string]()
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	if err := timeline.AddWithStrategy(*january, "a", Reject[string]()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := timeline.AddWithStrategy(*february, "b", Reject[string]()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	err := timeline.AddWithStrategy(Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}, "c", Reject[string]())
+	if err == nil {
+		t.Fatalf("Expected an error for an overlapping period")
+	}
+	if !strings.Contains(err.Error(), january.String()) {
+		t.Errorf("Expected error to describe overlapped period, got %q", err)
+	}
+
+	if len(timeline.Items) != 2 {
+		t.Errorf("Expected timeline to be unchanged, got %v", timeline.Items)
+	}
+}
+
+func TestTimeline_AddWithStrategy_ShouldOnlyArbitrateOverlappedItems(t *testing.T) {
+	overlapping := func() Timeline[string] {
+		timeline := NewTimeline[string]()
+		timeline.Add(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, "a")
+		timeline.Add(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 31)}, "b")
+		return timeline
+	}
+	june, _ := Month(2024, 6)
+
+	for _, strategy := range []ConflictStrategy[string]{Reject[string](), FirstWins[string](), LastWins[string]()} {
+		timeline := overlapping()
+		if err := timeline.AddWithStrategy(*june, "c", strategy); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		assertPeriodValues(t, []PeriodValue[string]{
+			{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, Value: "a"},
+			{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 31)}, Value: "b"},
+			{Period: *june, Value: "c"},
+		}, timeline.Items)
+	}
+
+	tests := []struct {
+		name     string
+		strategy ConflictStrategy[string]
+		expected []PeriodValue[string]
+	}{
+		{
+			name:     "last wins",
+			strategy: LastWins[string](),
+			expected: []PeriodValue[string]{
+				{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 15)}, Value: "a"},
+				{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 15)}, Value: "b"},
+				{Period: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 5)}, Value: "c"},
+			},
+		},
+		{
+			name:     "first wins",
+			strategy: FirstWins[string](),
+			expected: []PeriodValue[string]{
+				{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, Value: "a"},
+				{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 31)}, Value: "b"},
+				{Period: Period{Start: DateOnly(2024, 1, 31), End: DateOnly(2024, 2, 5)}, Value: "c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := overlapping()
+			if err := timeline.AddWithStrategy(Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 5)}, "c", tt.strategy); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			assertPeriodValues(t, tt.expected, timeline.Items)
+		})
+	}
+}
+
+func TestTimeLineBuilder_WithConflictStrategy(t *testing.T) {
+	type holiday struct {
+		name     string
+		priority int
+	}
+
+	timeline, err := NewTimeLineBuilder[holiday]().
+		WithConflictStrategy(ByPriority(func(h holiday) int { return h.priority })).
+		AddMonth(2024, 8, holiday{name: "summer", priority: 1}).
+		AddDay(2024, 8, 15, holiday{name: "assumption", priority: 2}).
+		AddPeriod(DateOnly(2024, 7, 25), DateOnly(2024, 8, 5), holiday{name: "trip", priority: 1}).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[holiday]{
+		{Period: Period{Start: DateOnly(2024, 7, 25), End: DateOnly(2024, 8, 5)}, Value: holiday{name: "trip", priority: 1}},
+		{Period: Period{Start: DateOnly(2024, 8, 5), End: DateOnly(2024, 8, 15)}, Value: holiday{name: "summer", priority: 1}},
+		{Period: Period{Start: DateOnly(2024, 8, 15), End: DateOnly(2024, 8, 16)}, Value: holiday{name: "assumption", priority: 2}},
+		{Period: Period{Start: DateOnly(2024, 8, 16), End: DateOnly(2024, 9, 1)}, Value: holiday{name: "summer", priority: 1}},
+	}, timeline.Items)
+}
@@ -0,0 +1,94 @@
+package timelines
+
+// ChangeKind tells how a period changed between two timelines.
+type ChangeKind int
+
+const (
+	// ChangeInserted means the period was not covered and now has a value.
+	ChangeInserted ChangeKind = iota
+	// ChangeRemoved means the period had a value and is no longer covered.
+	ChangeRemoved
+	// ChangeModified means the period has another value.
+	ChangeModified
+)
+
+var changeKindNames = [...]string{
+	ChangeInserted: "inserted",
+	ChangeRemoved:  "removed",
+	ChangeModified: "modified",
+}
+
+// String returns the name of the change kind.
+func (k ChangeKind) String() string {
+	if k < ChangeInserted || k > ChangeModified {
+		return "unknown"
+	}
+	return changeKindNames[k]
+}
+
+// Change describes how the value of a period changed. Old is the zero value for inserted periods,
+// and New is the zero value for removed ones.
+type Change[T any] struct {
+	Kind   ChangeKind
+	Period Period
+	Old    T
+	New    T
+}
+
+// Diff returns the changes turning timeline a into timeline b, values being compared with eq.
+// Contiguous periods having the same change are merged, so that changes are as few as possible.
+// Timeline items must be sorted and must not overlap.
+func Diff[T any](a Timeline[T], b Timeline[T], eq func(a T, b T) bool) ([]Change[T], error) {
+	type slice struct {
+		change  Change[T]
+		changed bool
+	}
+
+	sliced, err := Zip(a, b, func(period Period, before *T, after *T) slice {
+		switch {
+		case before == nil:
+			return slice{change: Change[T]{Kind: ChangeInserted, Period: period, New: *after}, changed: true}
+		case after == nil:
+			return slice{change: Change[T]{Kind: ChangeRemoved, Period: period, Old: *before}, changed: true}
+		case !eq(*before, *after):
+			return slice{change: Change[T]{Kind: ChangeModified, Period: period, Old: *before, New: *after}, changed: true}
+		default:
+			return slice{}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change[T]
+	for _, s := range sliced.Items {
+		if !s.Value.changed {
+			continue
+		}
+
+		current := s.Value.change
+		if last := len(changes) - 1; last >= 0 && changes[last].extendedBy(current, eq) {
+			changes[last].Period.End = current.Period.End
+			continue
+		}
+		changes = append(changes, current)
+	}
+
+	return changes, nil
+}
+
+// extendedBy checks if next change is the same change as c on the following period.
+func (c *Change[T]) extendedBy(next Change[T], eq func(a T, b T) bool) bool {
+	if c.Kind != next.Kind || !c.Period.End.Equal(next.Period.Start) {
+		return false
+	}
+
+	switch c.Kind {
+	case ChangeInserted:
+		return eq(c.New, next.New)
+	case ChangeRemoved:
+		return eq(c.Old, next.Old)
+	default:
+		return eq(c.Old, next.Old) && eq(c.New, next.New)
+	}
+}
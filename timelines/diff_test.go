@@ -0,0 +1,135 @@
+package timelines
+
+import (
+	"testing"
+)
+
+func equalInts(a int, b int) bool {
+	return a == b
+}
+
+func assertChanges(t *testing.T, expected []Change[int], actual []Change[int]) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d: %v", len(expected), len(actual), actual)
+	}
+
+	for i, e := range expected {
+		a := actual[i]
+		if a.Kind != e.Kind || !a.Period.Equal(e.Period) || a.Old != e.Old || a.New != e.New {
+			t.Errorf("Expected change %d to be %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 2, 1).
+		AddMonth(2024, 3, 3).
+		Build()
+
+	after, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 10), 1).
+		AddPeriod(DateOnly(2024, 1, 10), DateOnly(2024, 2, 20), 2).
+		AddMonth(2024, 3, 3).
+		AddMonth(2024, 5, 5).
+		Build()
+
+	changes, err := Diff(before, after, equalInts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertChanges(t, []Change[int]{
+		{Kind: ChangeModified, Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 2, 20)}, Old: 1, New: 2},
+		{Kind: ChangeRemoved, Period: Period{Start: DateOnly(2024, 2, 20), End: DateOnly(2024, 3, 1)}, Old: 1},
+		{Kind: ChangeInserted, Period: Period{Start: DateOnly(2024, 5, 1), End: DateOnly(2024, 6, 1)}, New: 5},
+	}, changes)
+
+	same, err := Diff(before, before.Optimize(equalInts), equalInts)
+	if err != nil || len(same) != 0 {
+		t.Errorf("Expected no change between equivalent timelines, got %v (%v)", same, err)
+	}
+
+	if ChangeModified.String() != "modified" {
+		t.Errorf("Unexpected name %s", ChangeModified)
+	}
+}
+
+func TestObservableDisjointTimeline_ShouldNotifySubscribers(t *testing.T) {
+	d, _ := NewDisjointTimeline[int](nil)
+	o := NewObservableDisjointTimeline(d, equalInts)
+
+	var received [][]Change[int]
+	unsubscribe := o.Subscribe(func(changes []Change[int]) {
+		received = append(received, changes)
+	})
+
+	january, _ := Month(2024, 1)
+	if err := o.Insert(*january, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	o.Set(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}, 2)
+	// no actual change
+	o.Set(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 15)}, 2)
+	o.Optimize(equalInts)
+	o.Delete(Period{Start: DateOnly(2024, 1, 25), End: DateOnly(2024, 2, 5)})
+
+	if len(received) != 3 {
+		t.Fatalf("Expected 3 notifications, got %d: %v", len(received), received)
+	}
+	assertChanges(t, []Change[int]{{Kind: ChangeInserted, Period: *january, New: 1}}, received[0])
+	assertChanges(t, []Change[int]{
+		{Kind: ChangeModified, Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}, Old: 1, New: 2},
+	}, received[1])
+	assertChanges(t, []Change[int]{
+		{Kind: ChangeRemoved, Period: Period{Start: DateOnly(2024, 1, 25), End: DateOnly(2024, 2, 1)}, Old: 1},
+	}, received[2])
+
+	unsubscribe()
+	o.Delete(*january)
+	if len(received) != 3 {
+		t.Errorf("Expected no notification after unsubscribe, got %d", len(received))
+	}
+	if len(o.Timeline().Items) != 0 {
+		t.Errorf("Expected empty timeline, got %v", o.Timeline().Items)
+	}
+}
+
+func TestObservableTimeline_ShouldNotifyAddAndOptimize(t *testing.T) {
+	o := NewObservableTimeline(NewTimeline[int]())
+
+	var received [][]Change[int]
+	o.Subscribe(func(changes []Change[int]) {
+		received = append(received, changes)
+	})
+
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+	overlapping := Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 2, 20)}
+	o.Add(*january, 1)
+	o.Add(overlapping, 2)
+	o.Add(*february, 1)
+	o.Optimize(equalInts)
+	// nothing left to merge
+	o.Optimize(equalInts)
+
+	if len(received) != 4 {
+		t.Fatalf("Expected 4 notifications, got %d: %v", len(received), received)
+	}
+	assertChanges(t, []Change[int]{{Kind: ChangeInserted, Period: *january, New: 1}}, received[0])
+	assertChanges(t, []Change[int]{{Kind: ChangeInserted, Period: overlapping, New: 2}}, received[1])
+	assertChanges(t, []Change[int]{{Kind: ChangeInserted, Period: *february, New: 1}}, received[2])
+
+	assertChanges(t, []Change[int]{
+		{Kind: ChangeRemoved, Period: *january, Old: 1},
+		{Kind: ChangeRemoved, Period: *february, Old: 1},
+		{Kind: ChangeInserted, Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 3, 1)}, New: 1},
+	}, received[3])
+
+	if values := o.ValuesAt(DateOnly(2024, 2, 15)); len(values) != 2 {
+		t.Errorf("Expected 2 values, got %v", values)
+	}
+}
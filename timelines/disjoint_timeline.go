@@ -0,0 +1,100 @@
+package timelines
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DisjointTimeline is a timeline whose items never overlap, so that each instant has at most one value.
+// Its mutators keep items sorted and disjoint.
+type DisjointTimeline[T any] struct {
+	timeline Timeline[T]
+}
+
+// NewDisjointTimeline creates a DisjointTimeline from given items, returning an error if two of them overlap.
+// Items having an empty period are ignored.
+func NewDisjointTimeline[T any](items []PeriodValue[T]) (*DisjointTimeline[T], error) {
+	sorted := make([]PeriodValue[T], 0, len(items))
+	for _, pv := range items {
+		if !pv.IsEmpty() {
+			sorted = append(sorted, pv)
+		}
+	}
+	sortStableByPeriodStart(sorted)
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Period.Start.Before(sorted[i-1].Period.End) {
+			return nil, fmt.Errorf("period %v overlaps period %v", sorted[i].Period, sorted[i-1].Period)
+		}
+	}
+
+	return &DisjointTimeline[T]{timeline: Timeline[T]{Items: sorted}}, nil
+}
+
+// ToDisjoint creates a DisjointTimeline from a Timeline, resolving its overlaps with strategy,
+// items being considered inserted in their current order.
+func ToDisjoint[T any](t Timeline[T], strategy ConflictStrategy[T]) (*DisjointTimeline[T], error) {
+	items, err := resolveWithStrategy(t.Items, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DisjointTimeline[T]{timeline: Timeline[T]{Items: items}}, nil
+}
+
+// Timeline returns a Timeline holding a copy of all items sorted by the Start date of their Periods.
+func (d *DisjointTimeline[T]) Timeline() Timeline[T] {
+	return Timeline[T]{Items: slices.Clone(d.timeline.Items)}
+}
+
+// Len returns the number of items.
+func (d *DisjointTimeline[T]) Len() int {
+	return len(d.timeline.Items)
+}
+
+// Insert adds a new PeriodValue, returning an error if its period overlaps an existing item.
+func (d *DisjointTimeline[T]) Insert(period Period, value T) error {
+	if period.IsEmpty() {
+		return nil
+	}
+
+	first, last := d.timeline.searchOverlapping(period)
+	if first < last {
+		return fmt.Errorf("period %v overlaps period %v", period, d.timeline.Items[first].Period)
+	}
+
+	d.timeline.Items = slices.Insert(d.timeline.Items, first, NewPeriodValue(period, value))
+	return nil
+}
+
+// Set gives value to given period, replacing any existing value on it.
+func (d *DisjointTimeline[T]) Set(period Period, value T) {
+	d.timeline.Set(period, value)
+}
+
+// Delete removes any value on given period, splitting items straddling its bounds.
+func (d *DisjointTimeline[T]) Delete(period Period) {
+	d.timeline.Delete(period)
+}
+
+// ValueAt returns the value covering given instant.
+func (d *DisjointTimeline[T]) ValueAt(instant time.Time) (T, bool) {
+	return d.timeline.ValueAt(instant)
+}
+
+// FindIntersects returns items overlapping given period.
+func (d *DisjointTimeline[T]) FindIntersects(period Period) []PeriodValue[T] {
+	first, last := d.timeline.searchOverlapping(period)
+	return slices.Clone(d.timeline.Items[first:last])
+}
+
+// Gaps returns periods within limit not covered by any item.
+func (d *DisjointTimeline[T]) Gaps(within Period) []Period {
+	return d.timeline.Gaps(within)
+}
+
+// Optimize merges all contiguous items having same value.
+func (d *DisjointTimeline[T]) Optimize(equalityComparer func(a T, b T) bool) {
+	d.timeline = d.timeline.Optimize(equalityComparer)
+}
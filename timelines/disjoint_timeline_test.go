@@ -0,0 +1,98 @@
+package timelines
+
+import (
+	"testing"
+)
+
+func TestNewDisjointTimeline_ShouldRejectOverlaps(t *testing.T) {
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	d, err := NewDisjointTimeline([]PeriodValue[int]{NewPeriodValue(*february, 2), NewPeriodValue(*january, 1)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertPeriodValues(t, []PeriodValue[int]{NewPeriodValue(*january, 1), NewPeriodValue(*february, 2)}, d.Timeline().Items)
+
+	overlapping := Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}
+	if _, err := NewDisjointTimeline([]PeriodValue[int]{NewPeriodValue(*january, 1), NewPeriodValue(overlapping, 2)}); err == nil {
+		t.Errorf("Expected an error for overlapping items")
+	}
+}
+
+func TestDisjointTimeline_Insert(t *testing.T) {
+	d, _ := NewDisjointTimeline[string](nil)
+	january, _ := Month(2024, 1)
+	march, _ := Month(2024, 3)
+	february, _ := Month(2024, 2)
+
+	for _, pv := range []PeriodValue[string]{NewPeriodValue(*january, "a"), NewPeriodValue(*march, "c"), NewPeriodValue(*february, "b")} {
+		if err := d.Insert(pv.Period, pv.Value); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if err := d.Insert(Period{Start: DateOnly(2024, 2, 28), End: DateOnly(2024, 3, 2)}, "x"); err == nil {
+		t.Errorf("Expected an error for an overlapping period")
+	}
+
+	assertPeriodValues(t, []PeriodValue[string]{
+		NewPeriodValue(*january, "a"),
+		NewPeriodValue(*february, "b"),
+		NewPeriodValue(*march, "c"),
+	}, d.Timeline().Items)
+
+	if v, ok := d.ValueAt(DateOnly(2024, 2, 1)); !ok || v != "b" {
+		t.Errorf("Expected b, got %v", v)
+	}
+
+	found := d.FindIntersects(Period{Start: DateOnly(2024, 1, 31), End: DateOnly(2024, 2, 2)})
+	assertPeriodValues(t, []PeriodValue[string]{NewPeriodValue(*january, "a"), NewPeriodValue(*february, "b")}, found)
+}
+
+func TestDisjointTimeline_SetDeleteAndOptimize(t *testing.T) {
+	d, _ := NewDisjointTimeline[int](nil)
+	year, _ := Year(2024)
+
+	d.Set(*year, 1)
+	d.Set(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 1)}, 2)
+	d.Delete(Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2024, 7, 1)})
+	d.Set(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 1)}, 1)
+	d.Optimize(func(a int, b int) bool { return a == b })
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 6, 1)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 7, 1), End: DateOnly(2025, 1, 1)}, Value: 1},
+	}, d.Timeline().Items)
+
+	gaps := d.Gaps(*year)
+	if len(gaps) != 1 || !gaps[0].Equal(Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2024, 7, 1)}) {
+		t.Errorf("Unexpected gaps %v", gaps)
+	}
+}
+
+func TestToDisjoint_ShouldResolveOverlaps(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddPeriod(DateOnly(2024, 1, 10), DateOnly(2024, 1, 20), 2).
+		Build()
+
+	d, err := ToDisjoint(timeline, LastWins[int]())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d.Len() != 3 {
+		t.Errorf("Expected 3 items, got %d", d.Len())
+	}
+
+	if _, err := ToDisjoint(timeline, Reject[int]()); err == nil {
+		t.Errorf("Expected an error rejecting overlaps")
+	}
+
+	// the returned Timeline is a copy
+	items := d.Timeline()
+	items.Items[0].Value = 42
+	if v, _ := d.ValueAt(DateOnly(2024, 1, 1)); v != 1 {
+		t.Errorf("Expected DisjointTimeline to be unchanged, got %v", v)
+	}
+}
@@ -0,0 +1,250 @@
+package timelines
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// openBound is the ISO 8601 notation of an unbounded start or end in interval strings.
+const openBound = ".."
+
+var boundNames = map[Bound]string{
+	Inclusive: "inclusive",
+	Exclusive: "exclusive",
+}
+
+// MarshalText encodes the bound as "inclusive" or "exclusive", DefaultBound being empty.
+func (b Bound) MarshalText() ([]byte, error) {
+	if b == DefaultBound {
+		return []byte{}, nil
+	}
+	name, ok := boundNames[b]
+	if !ok {
+		return nil, fmt.Errorf("invalid bound %d", int(b))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a bound from "inclusive" or "exclusive", empty meaning DefaultBound.
+func (b *Bound) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*b = DefaultBound
+		return nil
+	}
+	for bound, name := range boundNames {
+		if string(data) == name {
+			*b = bound
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid bound %q", data)
+}
+
+// periodJSON is the JSON representation of a Period. Infinite bounds are encoded as null,
+// and startBound and endBound are only given when the period is not half-open on that side.
+type periodJSON struct {
+	Start      *time.Time `json:"start"`
+	End        *time.Time `json:"end"`
+	StartBound Bound      `json:"startBound,omitempty"`
+	EndBound   Bound      `json:"endBound,omitempty"`
+}
+
+func (p Period) toJSON() periodJSON {
+	var encoded periodJSON
+	if !p.HasInfiniteStart() {
+		encoded.Start = &p.Start
+	}
+	if !p.HasInfiniteEnd() {
+		encoded.End = &p.End
+	}
+	if !p.IncludesStart() {
+		encoded.StartBound = Exclusive
+	}
+	if p.IncludesEnd() {
+		encoded.EndBound = Inclusive
+	}
+	return encoded
+}
+
+// periodBoundsJSON decodes the bounds of a periodJSON, telling missing bounds from null ones.
+type periodBoundsJSON struct {
+	Start      json.RawMessage `json:"start"`
+	End        json.RawMessage `json:"end"`
+	StartBound Bound           `json:"startBound"`
+	EndBound   Bound           `json:"endBound"`
+}
+
+func (p periodBoundsJSON) toPeriod() (Period, error) {
+	start, err := decodeBound("start", p.Start, NegativeInfinity)
+	if err != nil {
+		return Empty(), err
+	}
+	end, err := decodeBound("end", p.End, PositiveInfinity)
+	if err != nil {
+		return Empty(), err
+	}
+
+	period, err := NewPeriodWithBounds(start, p.StartBound, end, p.EndBound)
+	if err != nil {
+		return Empty(), err
+	}
+	return *period, nil
+}
+
+// decodeBound decodes a required bound, null meaning infinity.
+func decodeBound(name string, raw json.RawMessage, infinity time.Time) (time.Time, error) {
+	if raw == nil {
+		return time.Time{}, fmt.Errorf("period %s is missing", name)
+	}
+	if string(raw) == "null" {
+		return infinity, nil
+	}
+
+	var bound time.Time
+	if err := json.Unmarshal(raw, &bound); err != nil {
+		return time.Time{}, fmt.Errorf("invalid period %s: %w", name, err)
+	}
+	return bound, nil
+}
+
+// MarshalJSON encodes the period as an object having RFC 3339 start and end, null meaning unbounded,
+// with startBound or endBound when the period is not half-open.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toJSON())
+}
+
+// UnmarshalJSON decodes a period from an object having start and end, or from an ISO 8601 "start/end" string.
+// Both start and end are required, null meaning unbounded. End must be after start, as with NewPeriodWithBounds.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return p.UnmarshalText([]byte(text))
+	}
+
+	var decoded periodBoundsJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	period, err := decoded.toPeriod()
+	if err != nil {
+		return err
+	}
+	*p = period
+	return nil
+}
+
+// MarshalText encodes the period as an ISO 8601 "start/end" interval of RFC 3339 instants,
+// an unbounded side being written "..". A period which is not half-open is enclosed
+// in brackets, "[" and "]" for inclusive bounds and "(" and ")" for exclusive ones, as in "(start/end]".
+func (p Period) MarshalText() ([]byte, error) {
+	start, end := openBound, openBound
+	if !p.HasInfiniteStart() {
+		start = p.Start.Format(time.RFC3339Nano)
+	}
+	if !p.HasInfiniteEnd() {
+		end = p.End.Format(time.RFC3339Nano)
+	}
+
+	text := start + "/" + end
+	if p.IncludesStart() && !p.IncludesEnd() {
+		return []byte(text), nil
+	}
+
+	opening, closing := "[", ")"
+	if !p.IncludesStart() {
+		opening = "("
+	}
+	if p.IncludesEnd() {
+		closing = "]"
+	}
+	return []byte(opening + text + closing), nil
+}
+
+// UnmarshalText decodes a period from an ISO 8601 interval, in any form accepted by ParsePeriod,
+// possibly enclosed in brackets telling its bounds as written by MarshalText.
+// End must be after start, as with NewPeriodWithBounds.
+func (p *Period) UnmarshalText(data []byte) error {
+	text := string(data)
+	startBound, endBound := DefaultBound, DefaultBound
+	if len(text) >= 2 && strings.ContainsAny(text[:1], "[(") && strings.ContainsAny(text[len(text)-1:], "])") {
+		if text[0] == '(' {
+			startBound = Exclusive
+		}
+		if text[len(text)-1] == ']' {
+			endBound = Inclusive
+		}
+		text = text[1 : len(text)-1]
+	}
+
+	i, err := parseInterval(text)
+	if err != nil {
+		return err
+	}
+	period, err := NewPeriodWithBounds(i.start, startBound, i.end, endBound)
+	if err != nil {
+		return err
+	}
+	*p = *period
+	return nil
+}
+
+// periodValueJSON is the JSON representation of a PeriodValue.
+type periodValueJSON[T any] struct {
+	periodJSON
+	Value T `json:"value"`
+}
+
+// MarshalJSON encodes the PeriodValue as an object having start, end and value.
+func (p PeriodValue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(periodValueJSON[T]{periodJSON: p.Period.toJSON(), Value: p.Value})
+}
+
+// UnmarshalJSON decodes a PeriodValue from an object having start, end and value, and optionally startBound and endBound.
+// Both start and end are required, null meaning unbounded. End must be after start, as with NewPeriodWithBounds.
+func (p *PeriodValue[T]) UnmarshalJSON(data []byte) error {
+	var decoded struct {
+		periodBoundsJSON
+		Value T `json:"value"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	period, err := decoded.toPeriod()
+	if err != nil {
+		return err
+	}
+	*p = NewPeriodValue(period, decoded.Value)
+	return nil
+}
+
+// MarshalJSON encodes the Timeline as an array of PeriodValue.
+func (t Timeline[T]) MarshalJSON() ([]byte, error) {
+	if t.Items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(t.Items)
+}
+
+// UnmarshalJSON decodes a Timeline from an array of PeriodValue, sorting items by the Start date of their Periods.
+// Items having the same start keep their order, which matters to ResolveConflicts.
+func (t *Timeline[T]) UnmarshalJSON(data []byte) error {
+	var items []PeriodValue[T]
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	if items == nil {
+		items = []PeriodValue[T]{}
+	}
+	sortStableByPeriodStart(items)
+	t.Items = items
+	return nil
+}
@@ -0,0 +1,262 @@
+package timelines
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type contract struct {
+	Employee string  `json:"employee"`
+	Rate     float64 `json:"rate"`
+}
+
+func TestPeriod_MarshalJSON(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	data, err := json.Marshal(january)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `{"start":"2024-01-01T00:00:00Z","end":"2024-02-01T00:00:00Z"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	contract, _ := NewPeriodFrom(DateOnly(2024, 3, 1))
+	data, _ = json.Marshal(contract)
+
+	expected = `{"start":"2024-03-01T00:00:00Z","end":null}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+func TestPeriod_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expected    Period
+		expectError bool
+	}{
+		{
+			name:     "object",
+			data:     `{"start":"2024-01-01T00:00:00Z","end":"2024-02-01T00:00:00Z"}`,
+			expected: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)},
+		},
+		{
+			name:     "interval string",
+			data:     `"2024-01-01T00:00:00Z/2024-02-01T00:00:00+01:00"`,
+			expected: Period{Start: DateOnly(2024, 1, 1), End: time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "unbounded end",
+			data:     `{"start":"2024-03-01T00:00:00Z","end":null}`,
+			expected: Period{Start: DateOnly(2024, 3, 1), End: PositiveInfinity},
+		},
+		{
+			name:     "unbounded start string",
+			data:     `"../2024-03-01T00:00:00Z"`,
+			expected: Period{Start: NegativeInfinity, End: DateOnly(2024, 3, 1)},
+		},
+		{
+			name:        "end before start",
+			data:        `{"start":"2024-02-01T00:00:00Z","end":"2024-01-01T00:00:00Z"}`,
+			expectError: true,
+		},
+		{
+			name:        "empty period",
+			data:        `"2024-01-01T00:00:00Z/2024-01-01T00:00:00Z"`,
+			expectError: true,
+		},
+		{
+			name:        "missing separator",
+			data:        `"2024-01-01T00:00:00Z"`,
+			expectError: true,
+		},
+		{
+			name:        "missing keys",
+			data:        `{}`,
+			expectError: true,
+		},
+		{
+			name:        "missing end",
+			data:        `{"start":"2024-01-01T00:00:00Z"}`,
+			expectError: true,
+		},
+		{
+			name:        "misspelled start",
+			data:        `{"begin":"2024-01-01T00:00:00Z","end":"2024-02-01T00:00:00Z"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Period
+			err := json.Unmarshal([]byte(tt.data), &p)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got %v", p)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !p.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, p)
+			}
+		})
+	}
+}
+
+func TestPeriod_MarshalText_ShouldRoundTrip(t *testing.T) {
+	history, _ := NewPeriodUntil(time.Date(2024, 3, 1, 12, 30, 0, 500, time.UTC))
+
+	text, err := history.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(text) != "../2024-03-01T12:30:00.0000005Z" {
+		t.Errorf("Unexpected text %s", text)
+	}
+
+	var decoded Period
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !decoded.Equal(*history) {
+		t.Errorf("Expected %v, got %v", *history, decoded)
+	}
+}
+
+func TestPeriod_Bounds_ShouldRoundTrip(t *testing.T) {
+	closed, _ := NewPeriodWithBounds(DateOnly(2024, 1, 1), Inclusive, DateOnly(2024, 1, 31), Inclusive)
+	open, _ := NewPeriodWithBounds(DateOnly(2024, 1, 1), Exclusive, DateOnly(2024, 1, 31), Exclusive)
+
+	data, err := json.Marshal(closed)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"start":"2024-01-01T00:00:00Z","end":"2024-01-31T00:00:00Z","endBound":"inclusive"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	text, _ := open.MarshalText()
+	if string(text) != "(2024-01-01T00:00:00Z/2024-01-31T00:00:00Z)" {
+		t.Errorf("Unexpected text %s", text)
+	}
+
+	for _, period := range []Period{*closed, *open} {
+		var fromJSON, fromText Period
+		data, _ := json.Marshal(period)
+		if err := json.Unmarshal(data, &fromJSON); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		text, _ := period.MarshalText()
+		if err := fromText.UnmarshalText(text); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !fromJSON.Equal(period) || !fromText.Equal(period) {
+			t.Errorf("Expected %v, got %v and %v", period, fromJSON, fromText)
+		}
+	}
+
+	var invalid Period
+	if err := json.Unmarshal([]byte(`{"start":null,"end":null,"endBound":"closed"}`), &invalid); err == nil {
+		t.Errorf("Expected an error for an invalid bound")
+	}
+
+	testTimelineRoundTrip(t, Timeline[int]{Items: []PeriodValue[int]{NewPeriodValue(*closed, 1)}})
+}
+
+func testTimelineRoundTrip[T comparable](t *testing.T, timeline Timeline[T]) {
+	t.Helper()
+
+	data, err := json.Marshal(timeline)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded Timeline[T]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, timeline.Items, decoded.Items)
+}
+
+func TestTimeline_JSON_ShouldRoundTrip(t *testing.T) {
+	rates, _ := NewTimeLineBuilder[float64]().
+		AddMonth(2024, 1, 12.5).
+		AddDay(2024, 1, 15, 0.25).
+		Build()
+	testTimelineRoundTrip(t, rates)
+
+	names, _ := NewTimeLineBuilder[string]().
+		AddMonth(2024, 2, "february").
+		Build()
+	testTimelineRoundTrip(t, names)
+
+	contracts := NewTimeline[contract]()
+	unbounded, _ := NewPeriodFrom(DateOnly(2024, 3, 1))
+	contracts.Add(*unbounded, contract{Employee: "alice", Rate: 42})
+	testTimelineRoundTrip(t, contracts)
+
+	testTimelineRoundTrip(t, NewTimeline[int]())
+}
+
+func TestTimeline_UnmarshalJSON_ShouldSortAndValidate(t *testing.T) {
+	var timeline Timeline[int]
+	data := `[
+		{"start":"2024-02-01T00:00:00Z","end":"2024-03-01T00:00:00Z","value":2},
+		{"start":"2024-01-01T00:00:00Z","end":"2024-02-01T00:00:00Z","value":1}
+	]`
+
+	if err := json.Unmarshal([]byte(data), &timeline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if timeline.Items[0].Value != 1 || timeline.Items[1].Value != 2 {
+		t.Errorf("Expected items to be sorted, got %v", timeline.Items)
+	}
+
+	invalid := `[{"start":"2024-02-01T00:00:00Z","end":"2024-01-01T00:00:00Z","value":2}]`
+	if err := json.Unmarshal([]byte(invalid), &timeline); err == nil {
+		t.Errorf("Expected an error when end is before start")
+	}
+
+	missing := `[{"start":"2024-01-01T00:00:00Z","value":2}]`
+	if err := json.Unmarshal([]byte(missing), &timeline); err == nil {
+		t.Errorf("Expected an error when end is missing")
+	}
+}
+
+func TestTimeline_UnmarshalJSON_ShouldKeepOrderOfSameStarts(t *testing.T) {
+	timeline := NewTimeline[int]()
+	for i := 0; i < 100; i++ {
+		start := DateOnly(2024, 1, 1+i%3)
+		timeline.Items = append(timeline.Items, NewPeriodValue(Period{Start: start, End: start.AddDate(0, 0, 1+i%5)}, i))
+	}
+
+	data, err := json.Marshal(timeline)
+	if err != nil {
+		t.Fatalf("Could not marshal timeline: %v", err)
+	}
+	var decoded Timeline[int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sortStableByPeriodStart(timeline.Items)
+	assertPeriodValues(t, timeline.Items, decoded.Items)
+}
@@ -0,0 +1,45 @@
+package timelines
+
+// Gaps returns periods within limit not covered by any item. Timeline items must be sorted.
+func (t *Timeline[T]) Gaps(within Period) []Period {
+	var gaps []Period
+	cursor := within.Start
+
+	for _, pv := range t.Items {
+		// items are ordered, so if we are after limit then we finished scan
+		if !pv.Period.Start.Before(within.End) {
+			break
+		}
+
+		if pv.Period.Start.After(cursor) {
+			gaps = append(gaps, Period{Start: cursor, End: pv.Period.Start})
+		}
+		cursor = maxTime(cursor, pv.Period.End)
+	}
+
+	if cursor.Before(within.End) {
+		gaps = append(gaps, Period{Start: cursor, End: within.End})
+	}
+
+	return gaps
+}
+
+// FillGaps returns another Timeline where periods within limit not covered by any item
+// get a value computed by fill. Timeline items must be sorted.
+func (t *Timeline[T]) FillGaps(within Period, fill func(period Period) T) Timeline[T] {
+	complement := t.Complement(within, fill)
+
+	items := make([]PeriodValue[T], 0, len(t.Items)+len(complement.Items))
+	items = append(items, t.Items...)
+	items = append(items, complement.Items...)
+
+	filled := Timeline[T]{Items: items}
+	filled.SortTimelineByPeriodStart()
+
+	return filled
+}
+
+// Covers checks if every instant of given period is covered by at least one item. Timeline items must be sorted.
+func (t *Timeline[T]) Covers(period Period) bool {
+	return len(t.Gaps(period)) == 0
+}
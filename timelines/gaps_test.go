@@ -0,0 +1,80 @@
+package timelines
+
+import (
+	"testing"
+)
+
+func TestTimeline_Gaps_ShouldReturnUncoveredPeriods(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddPeriod(DateOnly(2024, 1, 10), DateOnly(2024, 2, 10), 10).
+		AddMonth(2024, 3, 300).
+		AddDay(2024, 3, 31, 5).
+		Build()
+
+	q1 := Period{Start: DateOnly(2023, 12, 15), End: DateOnly(2024, 4, 15)}
+	gaps := timeline.Gaps(q1)
+
+	expected := []Period{
+		{Start: DateOnly(2023, 12, 15), End: DateOnly(2024, 1, 1)},
+		{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 3, 1)},
+		{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 4, 15)},
+	}
+
+	if len(gaps) != len(expected) {
+		t.Fatalf("Expected %d gaps, got %d: %v", len(expected), len(gaps), gaps)
+	}
+
+	for i, p := range expected {
+		if !gaps[i].Equal(p) {
+			t.Errorf("Expected gap %d to be %v, got %v", i, p, gaps[i])
+		}
+	}
+}
+
+func TestTimeline_Gaps_ShouldBeEmptyWhenCovered(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 2, 200).
+		Build()
+
+	within := Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 15)}
+	if gaps := timeline.Gaps(within); len(gaps) != 0 {
+		t.Errorf("Expected no gaps, got %v", gaps)
+	}
+
+	if !timeline.Covers(within) {
+		t.Errorf("Expected timeline to cover %v", within)
+	}
+
+	march, _ := Month(2024, 3)
+	if timeline.Covers(*march) {
+		t.Errorf("Expected timeline not to cover %v", *march)
+	}
+
+	empty := NewTimeline[int]()
+	if !empty.Covers(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 1)}) {
+		t.Errorf("Expected an empty period to be covered")
+	}
+}
+
+func TestTimeline_FillGaps_ShouldKeepItemsSorted(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 2, 200).
+		AddMonth(2024, 4, 400).
+		Build()
+
+	q1 := Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 5, 1)}
+	filled := timeline.FillGaps(q1, func(p Period) int { return 0 })
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, Value: 0},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, Value: 200},
+		{Period: Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 1)}, Value: 0},
+		{Period: Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 5, 1)}, Value: 400},
+	}, filled.Items)
+
+	if !filled.Covers(q1) {
+		t.Errorf("Expected filled timeline to cover %v", q1)
+	}
+}
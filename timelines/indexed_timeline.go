@@ -0,0 +1,55 @@
+package timelines
+
+import (
+	"time"
+)
+
+// IndexedTimeline is a Timeline backed by an interval tree, so that lookups run in logarithmic time
+// instead of scanning all items.
+type IndexedTimeline[T any] struct {
+	index intervalTree[T]
+}
+
+// NewIndexedTimeline creates an IndexedTimeline holding the items of given Timeline.
+func NewIndexedTimeline[T any](t Timeline[T]) *IndexedTimeline[T] {
+	items := make([]PeriodValue[T], len(t.Items))
+	copy(items, t.Items)
+	sortStableByPeriodStart(items)
+
+	return &IndexedTimeline[T]{index: newIntervalTree(items)}
+}
+
+// Add adds a new PeriodValue to the IndexedTimeline.
+func (t *IndexedTimeline[T]) Add(newPeriod Period, newValue T) {
+	t.index.Insert(NewPeriodValue(newPeriod, newValue))
+}
+
+// Len returns the number of items.
+func (t *IndexedTimeline[T]) Len() int {
+	return t.index.Len()
+}
+
+// Timeline returns a Timeline holding all items sorted by the Start date of their Periods.
+func (t *IndexedTimeline[T]) Timeline() Timeline[T] {
+	return Timeline[T]{Items: t.index.Items()}
+}
+
+// FindIntersects returns items overlapping given period.
+func (t *IndexedTimeline[T]) FindIntersects(period Period) []PeriodValue[T] {
+	return t.index.Intersects(period)
+}
+
+// FindAt returns items covering given instant.
+func (t *IndexedTimeline[T]) FindAt(instant time.Time) []PeriodValue[T] {
+	return t.index.At(instant)
+}
+
+// FindContaining returns items whose period fully contains given period.
+func (t *IndexedTimeline[T]) FindContaining(period Period) []PeriodValue[T] {
+	return t.index.Containing(period)
+}
+
+// FindWithin returns items whose period is fully contained in given period.
+func (t *IndexedTimeline[T]) FindWithin(period Period) []PeriodValue[T] {
+	return t.index.Within(period)
+}
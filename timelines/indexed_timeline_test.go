@@ -0,0 +1,150 @@
+package timelines
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func randomTimeline(r *rand.Rand, count int, maxLength time.Duration) Timeline[int] {
+	origin := DateOnly(2024, 1, 1)
+	timeline := NewTimeline[int]()
+
+	for i := 0; i < count; i++ {
+		start := origin.Add(time.Duration(r.Int63n(int64(365 * 24 * time.Hour))))
+		end := start.Add(time.Minute + time.Duration(r.Int63n(int64(maxLength))))
+		timeline.Items = append(timeline.Items, NewPeriodValue(Period{Start: start, End: end}, i))
+	}
+	timeline.SortTimelineByPeriodStart()
+
+	return timeline
+}
+
+func samePeriodValues(a, b []PeriodValue[int]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	seen := make(map[int]int, len(a))
+	for _, pv := range a {
+		seen[pv.Value]++
+	}
+	for _, pv := range b {
+		seen[pv.Value]--
+	}
+	for _, count := range seen {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestIndexedTimeline_FindIntersects_ShouldReturnTwoPeriods(t *testing.T) {
+	timeline, err := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 123).
+		AddMonth(2024, 2, 456).
+		AddMonth(2024, 3, 69).
+		AddMonth(2024, 4, 987).
+		BuildIndexed()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	feb2024, _ := Month(2024, 2)
+	mar2024, _ := Month(2024, 3)
+
+	p, _ := NewPeriod(DateOnly(2024, 2, 15), DateOnly(2024, 3, 20))
+	result := timeline.FindIntersects(*p)
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(result))
+	}
+
+	if !result[0].Period.Equal(*feb2024) {
+		t.Errorf("Expected feb2024 to be %v, got %v", *feb2024, result[0].Period)
+	}
+
+	if !result[1].Period.Equal(*mar2024) {
+		t.Errorf("Expected mar2024 to be %v, got %v", *mar2024, result[1].Period)
+	}
+}
+
+func TestIndexedTimeline_Add_ShouldKeepItemsSorted(t *testing.T) {
+	timeline := NewIndexedTimeline(NewTimeline[int]())
+
+	for _, month := range []int{5, 1, 3, 2, 4} {
+		p, _ := Month(2024, month)
+		timeline.Add(*p, month)
+	}
+
+	items := timeline.Timeline().Items
+	if len(items) != 5 || timeline.Len() != 5 {
+		t.Fatalf("Expected 5 items, got %d", len(items))
+	}
+
+	for i, item := range items {
+		if item.Value != i+1 {
+			t.Errorf("Expected value %d at index %d, got %d", i+1, i, item.Value)
+		}
+	}
+}
+
+func TestIndexedTimeline_ShouldMatchLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	timeline := randomTimeline(r, 2000, 30*24*time.Hour)
+	indexed := NewIndexedTimeline(timeline)
+
+	for i := 0; i < 200; i++ {
+		query := randomTimeline(r, 1, 10*24*time.Hour).Items[0].Period
+
+		var intersects, containing, within, at []PeriodValue[int]
+		for _, pv := range timeline.Items {
+			if pv.Period.Intersects(query) {
+				intersects = append(intersects, pv)
+			}
+			if pv.Period.ContainsPeriod(query) {
+				containing = append(containing, pv)
+			}
+			if query.ContainsPeriod(pv.Period) {
+				within = append(within, pv)
+			}
+			if !pv.Period.Start.After(query.Start) && pv.Period.End.After(query.Start) {
+				at = append(at, pv)
+			}
+		}
+
+		if got := indexed.FindIntersects(query); !samePeriodValues(got, intersects) {
+			t.Errorf("FindIntersects(%v): expected %d items, got %d", query, len(intersects), len(got))
+		}
+		if got := indexed.FindContaining(query); !samePeriodValues(got, containing) {
+			t.Errorf("FindContaining(%v): expected %d items, got %d", query, len(containing), len(got))
+		}
+		if got := indexed.FindWithin(query); !samePeriodValues(got, within) {
+			t.Errorf("FindWithin(%v): expected %d items, got %d", query, len(within), len(got))
+		}
+		if got := indexed.FindAt(query.Start); !samePeriodValues(got, at) {
+			t.Errorf("FindAt(%v): expected %d items, got %d", query.Start, len(at), len(got))
+		}
+	}
+}
+
+func benchmarkQueries(b *testing.B, find func(period Period) []PeriodValue[int]) {
+	r := rand.New(rand.NewSource(7))
+	queries := randomTimeline(r, 1000, 24*time.Hour).Items
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		find(queries[i%len(queries)].Period)
+	}
+}
+
+func BenchmarkTimeline_FindIntersects(b *testing.B) {
+	timeline := randomTimeline(rand.New(rand.NewSource(1)), 100_000, 7*24*time.Hour)
+	benchmarkQueries(b, timeline.FindIntersects)
+}
+
+func BenchmarkIndexedTimeline_FindIntersects(b *testing.B) {
+	timeline := NewIndexedTimeline(randomTimeline(rand.New(rand.NewSource(1)), 100_000, 7*24*time.Hour))
+	benchmarkQueries(b, timeline.FindIntersects)
+}
@@ -0,0 +1,342 @@
+package timelines
+
+import (
+	"time"
+)
+
+// intervalNode is a node of an AVL tree ordered by period start and augmented with
+// the greatest end of its subtree.
+type intervalNode[T any] struct {
+	item PeriodValue[T]
+	// id identifies the node within a tree, copies included
+	id     uint64
+	maxEnd time.Time
+	height int
+	left   *intervalNode[T]
+	right  *intervalNode[T]
+}
+
+// intervalTree indexes PeriodValue items to answer overlap queries in logarithmic time.
+// Nodes are immutable once built, so copying a tree gives an independent version sharing its nodes.
+type intervalTree[T any] struct {
+	root   *intervalNode[T]
+	size   int
+	lastID uint64
+}
+
+// newIntervalTree builds a balanced tree from items already sorted by period start.
+func newIntervalTree[T any](sorted []PeriodValue[T]) intervalTree[T] {
+	return intervalTree[T]{root: buildIntervalNodes(sorted, 1), size: len(sorted), lastID: uint64(len(sorted))}
+}
+
+// buildIntervalNodes builds a balanced subtree, numbering nodes in order from firstID.
+func buildIntervalNodes[T any](sorted []PeriodValue[T], firstID uint64) *intervalNode[T] {
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	middle := len(sorted) / 2
+	n := &intervalNode[T]{
+		item:  sorted[middle],
+		id:    firstID + uint64(middle),
+		left:  buildIntervalNodes(sorted[:middle], firstID),
+		right: buildIntervalNodes(sorted[middle+1:], firstID+uint64(middle)+1),
+	}
+	n.update()
+
+	return n
+}
+
+func (n *intervalNode[T]) nodeHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes height and maxEnd from the children.
+func (n *intervalNode[T]) update() {
+	n.height = 1 + max(n.left.nodeHeight(), n.right.nodeHeight())
+	n.maxEnd = n.item.Period.End
+	if n.left != nil {
+		n.maxEnd = maxTime(n.maxEnd, n.left.maxEnd)
+	}
+	if n.right != nil {
+		n.maxEnd = maxTime(n.maxEnd, n.right.maxEnd)
+	}
+}
+
+// rotateLeft rotates n, which must not be shared, copying the child it moves up.
+func (n *intervalNode[T]) rotateLeft() *intervalNode[T] {
+	r := *n.right
+	n.right = r.left
+	n.update()
+	r.left = n
+	r.update()
+	return &r
+}
+
+// rotateRight rotates n, which must not be shared, copying the child it moves up.
+func (n *intervalNode[T]) rotateRight() *intervalNode[T] {
+	l := *n.left
+	n.left = l.right
+	n.update()
+	l.right = n
+	l.update()
+	return &l
+}
+
+// balance restores the AVL invariant on n, which must not be shared, and returns the new subtree root.
+func (n *intervalNode[T]) balance() *intervalNode[T] {
+	n.update()
+
+	switch factor := n.left.nodeHeight() - n.right.nodeHeight(); {
+	case factor > 1:
+		if n.left.left.nodeHeight() < n.left.right.nodeHeight() {
+			left := *n.left
+			n.left = left.rotateLeft()
+		}
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.nodeHeight() < n.right.left.nodeHeight() {
+			right := *n.right
+			n.right = right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+// insert returns a new subtree holding item. Nodes are never modified but copied along the path,
+// so that previous versions of the tree remain valid.
+func (n *intervalNode[T]) insert(item PeriodValue[T], id uint64) *intervalNode[T] {
+	if n == nil {
+		node := &intervalNode[T]{item: item, id: id}
+		node.update()
+		return node
+	}
+
+	c := *n
+	// equal starts go to the right so that insertion order is kept
+	if item.Period.Start.Before(n.item.Period.Start) {
+		c.left = n.left.insert(item, id)
+	} else {
+		c.right = n.right.insert(item, id)
+	}
+
+	return c.balance()
+}
+
+// remove returns a new subtree without target node, copying nodes along the path, and whether target was found.
+func (n *intervalNode[T]) remove(target *intervalNode[T]) (*intervalNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	if n.id == target.id {
+		return n.removeRoot(), true
+	}
+
+	// rotations may move items having the same start on both sides
+	start := target.item.Period.Start
+	if !start.After(n.item.Period.Start) {
+		if left, found := n.left.remove(target); found {
+			c := *n
+			c.left = left
+			return c.balance(), true
+		}
+	}
+	if !start.Before(n.item.Period.Start) {
+		if right, found := n.right.remove(target); found {
+			c := *n
+			c.right = right
+			return c.balance(), true
+		}
+	}
+
+	return n, false
+}
+
+// removeRoot returns a new subtree holding the items of n but its own.
+func (n *intervalNode[T]) removeRoot() *intervalNode[T] {
+	switch {
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	}
+
+	right, successor := n.right.removeMin()
+	c := *successor
+	c.left = n.left
+	c.right = right
+	return c.balance()
+}
+
+// removeMin returns a new subtree without its first node, and that node.
+func (n *intervalNode[T]) removeMin() (*intervalNode[T], *intervalNode[T]) {
+	if n.left == nil {
+		return n.right, n
+	}
+
+	left, first := n.left.removeMin()
+	c := *n
+	c.left = left
+	return c.balance(), first
+}
+
+// Insert adds an item to the tree.
+func (t *intervalTree[T]) Insert(item PeriodValue[T]) {
+	t.lastID++
+	t.root = t.root.insert(item, t.lastID)
+	t.size++
+}
+
+// remove removes given node, or its copy, from the tree.
+func (t *intervalTree[T]) remove(target *intervalNode[T]) {
+	if root, found := t.root.remove(target); found {
+		t.root = root
+		t.size--
+	}
+}
+
+// Len returns the number of items in the tree.
+func (t *intervalTree[T]) Len() int {
+	return t.size
+}
+
+// Items returns all items ordered by period start.
+func (t *intervalTree[T]) Items() []PeriodValue[T] {
+	items := make([]PeriodValue[T], 0, t.size)
+	t.root.walk(func(item PeriodValue[T]) {
+		items = append(items, item)
+	})
+	return items
+}
+
+func (n *intervalNode[T]) walk(f func(item PeriodValue[T])) {
+	if n == nil {
+		return
+	}
+	n.left.walk(f)
+	f(n.item)
+	n.right.walk(f)
+}
+
+// all yields items in order, returning false if yield stopped the iteration.
+func (n *intervalNode[T]) all(yield func(PeriodValue[T]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.all(yield) && yield(n.item) && n.right.all(yield)
+}
+
+// Intersects returns items overlapping period, ordered by period start.
+func (t *intervalTree[T]) Intersects(period Period) []PeriodValue[T] {
+	var items []PeriodValue[T]
+	for _, n := range t.intersectingNodes(period) {
+		items = append(items, n.item)
+	}
+	return items
+}
+
+// intersectingNodes returns nodes whose item overlaps period, ordered by period start.
+func (t *intervalTree[T]) intersectingNodes(period Period) []*intervalNode[T] {
+	var nodes []*intervalNode[T]
+	t.root.intersects(period, &nodes)
+	return nodes
+}
+
+func (n *intervalNode[T]) intersects(period Period, nodes *[]*intervalNode[T]) {
+	// nothing in this subtree ends after period starts
+	if n == nil || !n.maxEnd.After(period.Start) {
+		return
+	}
+
+	n.left.intersects(period, nodes)
+
+	// right subtree starts even later
+	if !n.item.Period.Start.Before(period.End) {
+		return
+	}
+
+	if n.item.Period.Intersects(period) {
+		*nodes = append(*nodes, n)
+	}
+	n.right.intersects(period, nodes)
+}
+
+// At returns items covering given instant, ordered by period start.
+func (t *intervalTree[T]) At(instant time.Time) []PeriodValue[T] {
+	var items []PeriodValue[T]
+	t.root.at(instant, &items)
+	return items
+}
+
+func (n *intervalNode[T]) at(instant time.Time, items *[]PeriodValue[T]) {
+	if n == nil || !n.maxEnd.After(instant) {
+		return
+	}
+
+	n.left.at(instant, items)
+
+	if n.item.Period.Start.After(instant) {
+		return
+	}
+
+	if n.item.Period.End.After(instant) {
+		*items = append(*items, n.item)
+	}
+	n.right.at(instant, items)
+}
+
+// Containing returns items fully containing period, ordered by period start.
+func (t *intervalTree[T]) Containing(period Period) []PeriodValue[T] {
+	var items []PeriodValue[T]
+	t.root.containing(period, &items)
+	return items
+}
+
+func (n *intervalNode[T]) containing(period Period, items *[]PeriodValue[T]) {
+	if n == nil || n.maxEnd.Before(period.End) {
+		return
+	}
+
+	n.left.containing(period, items)
+
+	if n.item.Period.Start.After(period.Start) {
+		return
+	}
+
+	if n.item.Period.ContainsPeriod(period) {
+		*items = append(*items, n.item)
+	}
+	n.right.containing(period, items)
+}
+
+// Within returns items fully contained in period, ordered by period start.
+func (t *intervalTree[T]) Within(period Period) []PeriodValue[T] {
+	var items []PeriodValue[T]
+	t.root.within(period, &items)
+	return items
+}
+
+func (n *intervalNode[T]) within(period Period, items *[]PeriodValue[T]) {
+	if n == nil || n.maxEnd.Before(period.Start) {
+		return
+	}
+
+	// left subtree only holds earlier starts
+	if !n.item.Period.Start.Before(period.Start) {
+		n.left.within(period, items)
+	}
+
+	if !n.item.Period.Start.Before(period.End) {
+		return
+	}
+
+	if period.ContainsPeriod(n.item.Period) {
+		*items = append(*items, n.item)
+	}
+	n.right.within(period, items)
+}
@@ -0,0 +1,235 @@
+package timelines
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// instantLayouts are the ISO 8601 instant layouts accepted in intervals, instants without offset being UTC.
+var instantLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+func parseInstant(s string) (time.Time, error) {
+	for _, layout := range instantLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid instant %q", s)
+}
+
+// isoDuration is an ISO 8601 duration. Calendar parts are applied with AddDate so that
+// months and days follow the calendar of the instant they are added to.
+type isoDuration struct {
+	years  int
+	months int
+	days   int
+	clock  time.Duration
+}
+
+// maxDurationYears bounds each calendar part of a duration, so that adding all of them to an instant
+// of a four digits year stays within representable instants.
+const maxDurationYears = 50_000_000_000
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+func parseISODuration(s string) (isoDuration, error) {
+	matches := isoDurationPattern.FindStringSubmatch(s)
+	if matches == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return isoDuration{}, fmt.Errorf("invalid duration %q", s)
+	}
+
+	// numbers of years, months, weeks, days, hours and minutes, missing parts being zero
+	var numbers [7]int
+	for i := 1; i < len(numbers); i++ {
+		if matches[i] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i])
+		if err != nil {
+			return isoDuration{}, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		numbers[i] = n
+	}
+
+	if numbers[1] > maxDurationYears || numbers[2] > 12*maxDurationYears ||
+		numbers[3] > 366*maxDurationYears/7 || numbers[4] > 366*maxDurationYears {
+		return isoDuration{}, fmt.Errorf("invalid duration %q: calendar part is too long", s)
+	}
+
+	hours, minutes := time.Duration(numbers[5]), time.Duration(numbers[6])
+	if hours > math.MaxInt64/time.Hour || minutes > (math.MaxInt64-hours*time.Hour)/time.Minute {
+		return isoDuration{}, fmt.Errorf("invalid duration %q: time part is too long", s)
+	}
+
+	d := isoDuration{
+		years:  numbers[1],
+		months: numbers[2],
+		days:   numbers[3]*7 + numbers[4],
+		clock:  hours*time.Hour + minutes*time.Minute,
+	}
+
+	if matches[7] != "" {
+		seconds, err := strconv.ParseFloat(strings.Replace(matches[7], ",", ".", 1), 64)
+		if err != nil {
+			return isoDuration{}, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		if seconds*float64(time.Second) >= float64(math.MaxInt64-d.clock) {
+			return isoDuration{}, fmt.Errorf("invalid duration %q: time part is too long", s)
+		}
+		d.clock += time.Duration(seconds * float64(time.Second))
+	}
+
+	return d, nil
+}
+
+func (d isoDuration) addTo(t time.Time) time.Time {
+	return t.AddDate(d.years, d.months, d.days).Add(d.clock)
+}
+
+func (d isoDuration) subtractFrom(t time.Time) time.Time {
+	return t.AddDate(-d.years, -d.months, -d.days).Add(-d.clock)
+}
+
+// interval is a parsed ISO 8601 interval, either bound being possibly expressed as a duration from the other one.
+type interval struct {
+	start    time.Time
+	end      time.Time
+	duration *isoDuration
+	backward bool
+}
+
+func parseInterval(s string) (interval, error) {
+	first, second, found := strings.Cut(s, "/")
+	if !found {
+		return interval{}, fmt.Errorf("invalid period %q: expected start/end", s)
+	}
+
+	parseBound := func(text string, infinity time.Time) (time.Time, *isoDuration, error) {
+		if text == openBound {
+			return infinity, nil, nil
+		}
+		if strings.HasPrefix(text, "P") {
+			d, err := parseISODuration(text)
+			return time.Time{}, &d, err
+		}
+		t, err := parseInstant(text)
+		return t, nil, err
+	}
+
+	start, startDuration, err := parseBound(first, NegativeInfinity)
+	if err != nil {
+		return interval{}, fmt.Errorf("invalid period %q: %w", s, err)
+	}
+	end, endDuration, err := parseBound(second, PositiveInfinity)
+	if err != nil {
+		return interval{}, fmt.Errorf("invalid period %q: %w", s, err)
+	}
+
+	switch {
+	case startDuration != nil && endDuration != nil:
+		return interval{}, fmt.Errorf("invalid period %q: start and end cannot both be durations", s)
+	case startDuration != nil:
+		if isInfinite(end) {
+			return interval{}, fmt.Errorf("invalid period %q: duration needs a finite end", s)
+		}
+		return interval{start: startDuration.subtractFrom(end), end: end, duration: startDuration, backward: true}, nil
+	case endDuration != nil:
+		if isInfinite(start) {
+			return interval{}, fmt.Errorf("invalid period %q: duration needs a finite start", s)
+		}
+		return interval{start: start, end: endDuration.addTo(start), duration: endDuration}, nil
+	default:
+		return interval{start: start, end: end}, nil
+	}
+}
+
+// ParsePeriod parses an ISO 8601 interval: "start/end", "start/duration" or "duration/end",
+// for instance "2024-01-01/P1M" or "2024-01-01T00:00Z/2024-02-01T00:00Z".
+// Instants without offset are UTC, and ".." stands for an unbounded side.
+func ParsePeriod(s string) (*Period, error) {
+	i, err := parseInterval(s)
+	if err != nil {
+		return nil, err
+	}
+	return NewPeriod(i.start, i.end)
+}
+
+// ParseRepeatingPeriod parses an ISO 8601 repeating interval such as "R12/2024-01-01/P1M"
+// and returns each repetition, like SplitByMonths does. Without a repetition count ("R/..."), periods never stop,
+// so the caller must stop reading at some point: prefer ParseRepeatingPeriodSeq in that case.
+// Repetitions of a "duration/end" interval go backward in time, starting with the one ending at end.
+func ParseRepeatingPeriod(s string) (<-chan Period, error) {
+	seq, err := ParseRepeatingPeriodSeq(s)
+	if err != nil {
+		return nil, err
+	}
+	return toChannel(seq), nil
+}
+
+// ParseRepeatingPeriodSeq is ParseRepeatingPeriod returning an iterator over the repetitions.
+func ParseRepeatingPeriodSeq(s string) (iter.Seq[Period], error) {
+	repeat, rest, found := strings.Cut(s, "/")
+	if !found || !strings.HasPrefix(repeat, "R") {
+		return nil, fmt.Errorf("invalid repeating period %q: expected Rn/start/end", s)
+	}
+
+	count := -1
+	if repeat != "R" {
+		n, err := strconv.Atoi(repeat[1:])
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid repeating period %q: invalid repetition count", s)
+		}
+		count = n
+	}
+
+	i, err := parseInterval(rest)
+	if err != nil {
+		return nil, err
+	}
+	first, err := NewPeriod(i.start, i.end)
+	if err != nil {
+		return nil, err
+	}
+	if !first.IsBounded() {
+		return nil, errors.New("repeating period must be bounded")
+	}
+
+	next := func(p Period) Period {
+		switch {
+		case i.duration != nil && i.backward:
+			return Period{Start: i.duration.subtractFrom(p.Start), End: p.Start}
+		case i.duration != nil:
+			return Period{Start: p.End, End: i.duration.addTo(p.End)}
+		default:
+			return Period{Start: p.End, End: p.End.Add(p.Duration())}
+		}
+	}
+
+	return func(yield func(Period) bool) {
+		current := *first
+		for n := 0; count < 0 || n < count; n++ {
+			if !yield(current) {
+				return
+			}
+			current = next(current)
+		}
+	}, nil
+}
+
+// String returns the period as an ISO 8601 "start/end" interval, in brackets when not half-open.
+func (p Period) String() string {
+	text, _ := p.MarshalText()
+	return string(text)
+}
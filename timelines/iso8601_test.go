@@ -0,0 +1,202 @@
+package timelines
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		expected    Period
+		expectError bool
+	}{
+		{
+			name:     "start and end",
+			text:     "2024-01-01T00:00Z/2024-02-01T00:00Z",
+			expected: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)},
+		},
+		{
+			name:     "start and month duration",
+			text:     "2024-01-01/P1M",
+			expected: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)},
+		},
+		{
+			name:     "start and clock duration",
+			text:     "2024-01-01T08:00:00Z/PT1H30M0.5S",
+			expected: Period{Start: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 1, 9, 30, 0, 500000000, time.UTC)},
+		},
+		{
+			name:     "duration and end",
+			text:     "P1W/2024-01-15",
+			expected: Period{Start: DateOnly(2024, 1, 8), End: DateOnly(2024, 1, 15)},
+		},
+		{
+			name:     "offset instants",
+			text:     "2024-01-01T00:00:00+01:00/2024-01-02T00:00:00+01:00",
+			expected: Period{Start: time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "unbounded end",
+			text:     "2024-03-01/..",
+			expected: Period{Start: DateOnly(2024, 3, 1), End: PositiveInfinity},
+		},
+		{name: "two durations", text: "P1D/P1M", expectError: true},
+		{name: "duration from unbounded", text: "../P1D", expectError: true},
+		{name: "empty duration", text: "2024-01-01/P", expectError: true},
+		{name: "empty time duration", text: "2024-01-01/PT", expectError: true},
+		{name: "oversized duration", text: "2024-01-01/P99999999999999999999Y", expectError: true},
+		{name: "oversized years", text: "2024-01-01/P9000000000000000000Y", expectError: true},
+		{name: "oversized weeks", text: "2024-01-01/P9000000000000000000W", expectError: true},
+		{name: "wrapping hours", text: "2024-01-01/PT5200000H", expectError: true},
+		{name: "wrapping minutes", text: "2024-01-01/PT2000000H99999999999M", expectError: true},
+		{name: "wrapping seconds", text: "2024-01-01/PT9999999999999S", expectError: true},
+		{name: "end before start", text: "2024-02-01/2024-01-01", expectError: true},
+		{name: "invalid instant", text: "2024-13-01/P1D", expectError: true},
+		{name: "no separator", text: "2024-01-01", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePeriod(tt.text)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got %v", p)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !p.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, *p)
+			}
+		})
+	}
+}
+
+func TestPeriod_String_ShouldBeParsable(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	if january.String() != "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z" {
+		t.Errorf("Unexpected string %s", january.String())
+	}
+
+	parsed, err := ParsePeriod(january.String())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !parsed.Equal(*january) {
+		t.Errorf("Expected %v, got %v", *january, *parsed)
+	}
+}
+
+func TestParseRepeatingPeriod_ShouldExpandMonths(t *testing.T) {
+	periods, err := ParseRepeatingPeriod("R12/2024-01-01/P1M")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	year, _ := Year(2024)
+	expected := year.SplitByMonths()
+
+	count := 0
+	for p := range periods {
+		e := <-expected
+		if !p.Equal(e) {
+			t.Errorf("Expected %v, got %v", e, p)
+		}
+		count++
+	}
+
+	if count != 12 {
+		t.Errorf("Expected 12 periods, got %d", count)
+	}
+}
+
+func TestParseRepeatingPeriod_Forms(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []Period
+	}{
+		{
+			name: "start and end",
+			text: "R3/2024-01-01/2024-01-03",
+			expected: []Period{
+				{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 3)},
+				{Start: DateOnly(2024, 1, 3), End: DateOnly(2024, 1, 5)},
+				{Start: DateOnly(2024, 1, 5), End: DateOnly(2024, 1, 7)},
+			},
+		},
+		{
+			name: "duration and end",
+			text: "R2/P1D/2024-01-10",
+			expected: []Period{
+				{Start: DateOnly(2024, 1, 9), End: DateOnly(2024, 1, 10)},
+				{Start: DateOnly(2024, 1, 8), End: DateOnly(2024, 1, 9)},
+			},
+		},
+		{
+			name:     "no repetition",
+			text:     "R0/2024-01-01/P1D",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods, err := ParseRepeatingPeriod(tt.text)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var results []Period
+			for p := range periods {
+				results = append(results, p)
+			}
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.expected), len(results))
+			}
+
+			for i, e := range tt.expected {
+				if !results[i].Equal(e) {
+					t.Errorf("Expected %v, got %v", e, results[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRepeatingPeriod_Unbounded(t *testing.T) {
+	periods, err := ParseRepeatingPeriodSeq("R/2024-01-01/P1D")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var last Period
+	count := 0
+	for p := range periods {
+		last = p
+		count++
+		if count == 400 {
+			break
+		}
+	}
+
+	if !last.Equal(Period{Start: DateOnly(2025, 2, 3), End: DateOnly(2025, 2, 4)}) {
+		t.Errorf("Unexpected 400th period %v", last)
+	}
+
+	for _, invalid := range []string{"2024-01-01/P1D", "Rx/2024-01-01/P1D", "R-1/2024-01-01/P1D", "R2/2024-01-01/.."} {
+		if _, err := ParseRepeatingPeriod(invalid); err == nil {
+			t.Errorf("Expected an error for %q", invalid)
+		}
+	}
+}
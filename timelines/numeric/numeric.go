@@ -0,0 +1,127 @@
+// Package numeric provides arithmetic on timelines of numbers.
+package numeric
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/codeanythingpossible/GoTimelines/timelines"
+)
+
+// Number is the set of numeric types timelines can compute with.
+type Number = timelines.Number
+
+func sum[N Number](period timelines.Period, a N, b N) N {
+	return a + b
+}
+
+// resolve returns disjoint items of the timeline, overlapping values being summed.
+func resolve[N Number](t timelines.Timeline[N]) ([]timelines.PeriodValue[N], error) {
+	resolved, err := t.ResolveConflicts(sum[N])
+	if err != nil {
+		return nil, err
+	}
+	return resolved.Items, nil
+}
+
+// Add returns a timeline where values of both timelines are summed, slicing periods like Timeline.Aggregate does.
+func Add[N Number](a timelines.Timeline[N], b timelines.Timeline[N]) (timelines.Timeline[N], error) {
+	return a.Aggregate(&b, sum[N])
+}
+
+// Subtract returns a timeline where values of b are subtracted from values of a, slicing periods like Timeline.Aggregate does.
+// Periods only covered by b get the opposite of its values.
+func Subtract[N Number](a timelines.Timeline[N], b timelines.Timeline[N]) (timelines.Timeline[N], error) {
+	var minusOne N
+	minusOne--
+	return Add(a, Scale(b, minusOne))
+}
+
+// Multiply returns a timeline covering periods covered by both timelines, with values of a multiplied by values of b.
+// Overlapping values within each timeline are summed first.
+func Multiply[N Number](a timelines.Timeline[N], b timelines.Timeline[N]) (timelines.Timeline[N], error) {
+	left, err := resolve(a)
+	if err != nil {
+		return timelines.Timeline[N]{}, err
+	}
+	right, err := resolve(b)
+	if err != nil {
+		return timelines.Timeline[N]{}, err
+	}
+
+	return timelines.ZipInner(timelines.Timeline[N]{Items: left}, timelines.Timeline[N]{Items: right}, func(period timelines.Period, a N, b N) N {
+		return a * b
+	})
+}
+
+// Scale returns a timeline having all values multiplied by factor.
+func Scale[N Number](t timelines.Timeline[N], factor N) timelines.Timeline[N] {
+	items := make([]timelines.PeriodValue[N], 0, len(t.Items))
+	for _, pv := range t.Items {
+		items = append(items, timelines.NewPeriodValue(pv.Period, pv.Value*factor))
+	}
+	return timelines.Timeline[N]{Items: items}
+}
+
+// Integrate returns the sum of values multiplied by the duration of their period, expressed in given unit.
+// For instance, a timeline of hourly rates integrated with time.Hour gives the total amount.
+// Timeline periods must be bounded.
+func Integrate[N Number](t timelines.Timeline[N], unit time.Duration) float64 {
+	var total float64
+	for _, pv := range t.Items {
+		total += float64(pv.Value) * float64(pv.Period.Duration()) / float64(unit)
+	}
+	return total
+}
+
+// Cumulative returns a timeline where each value is the running total of values up to its period.
+// Overlapping values are summed first, slicing periods like Timeline.ResolveConflicts does.
+func Cumulative[N Number](t timelines.Timeline[N]) (timelines.Timeline[N], error) {
+	items, err := resolve(t)
+	if err != nil {
+		return timelines.Timeline[N]{}, err
+	}
+
+	var total N
+	result := timelines.Timeline[N]{Items: make([]timelines.PeriodValue[N], 0, len(items))}
+	for _, pv := range items {
+		total += pv.Value
+		result.Items = append(result.Items, timelines.NewPeriodValue(pv.Period, total))
+	}
+
+	return result, nil
+}
+
+// MovingWindow returns a timeline where each value is reduced from the items intersecting the window
+// of given duration ending with its period. Overlapping values are summed first, slicing periods
+// like Timeline.ResolveConflicts does. Window must be positive.
+func MovingWindow[N Number](t timelines.Timeline[N], window time.Duration, reduce timelines.Reducer[N]) (timelines.Timeline[N], error) {
+	if window <= 0 {
+		return timelines.Timeline[N]{}, fmt.Errorf("window must be positive, got %v", window)
+	}
+
+	items, err := resolve(t)
+	if err != nil {
+		return timelines.Timeline[N]{}, err
+	}
+
+	result := timelines.Timeline[N]{Items: make([]timelines.PeriodValue[N], 0, len(items))}
+	first := 0
+	for i, pv := range items {
+		bucket := timelines.Period{Start: pv.Period.End.Add(-window), End: pv.Period.End}
+
+		// items are disjoint and sorted, so ends are increasing too
+		for first < i && !items[first].Period.End.After(bucket.Start) {
+			first++
+		}
+
+		result.Items = append(result.Items, timelines.NewPeriodValue(pv.Period, reduce(bucket, items[first:i+1])))
+	}
+
+	return result, nil
+}
+
+// MovingAverage is MovingWindow computing the time weighted average of covered instants within each window.
+func MovingAverage[N Number](t timelines.Timeline[N], window time.Duration) (timelines.Timeline[N], error) {
+	return MovingWindow(t, window, timelines.TimeWeightedAverage[N]())
+}
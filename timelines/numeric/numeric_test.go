@@ -0,0 +1,175 @@
+package numeric
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/codeanythingpossible/GoTimelines/timelines"
+)
+
+func assertPeriodValues[N Number](t *testing.T, expected []timelines.PeriodValue[N], actual []timelines.PeriodValue[N]) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d items, got %d: %v", len(expected), len(actual), actual)
+	}
+
+	for i, e := range expected {
+		if !actual[i].Period.Equal(e.Period) || actual[i].Value != e.Value {
+			t.Errorf("Expected item %d to be %v, got %v", i, e, actual[i])
+		}
+	}
+}
+
+func days(from int, to int) timelines.Period {
+	return timelines.Period{Start: timelines.DateOnly(2024, 1, from), End: timelines.DateOnly(2024, 1, to)}
+}
+
+func build[N Number](t *testing.T, items ...timelines.PeriodValue[N]) timelines.Timeline[N] {
+	t.Helper()
+
+	builder := timelines.NewTimeLineBuilder[N]()
+	for _, pv := range items {
+		builder.AddPeriodValue(pv)
+	}
+
+	timeline, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+	return timeline
+}
+
+func TestAdd_And_Subtract(t *testing.T) {
+	a := build(t, timelines.NewPeriodValue(days(1, 11), 10))
+	b := build(t, timelines.NewPeriodValue(days(6, 16), 3))
+
+	added, err := Add(a, b)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertPeriodValues(t, []timelines.PeriodValue[int]{
+		timelines.NewPeriodValue(days(1, 6), 10),
+		timelines.NewPeriodValue(days(6, 11), 13),
+		timelines.NewPeriodValue(days(11, 16), 3),
+	}, added.Items)
+
+	subtracted, err := Subtract(a, b)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertPeriodValues(t, []timelines.PeriodValue[int]{
+		timelines.NewPeriodValue(days(1, 6), 10),
+		timelines.NewPeriodValue(days(6, 11), 7),
+		timelines.NewPeriodValue(days(11, 16), -3),
+	}, subtracted.Items)
+}
+
+func TestMultiply_ShouldKeepCommonPeriods(t *testing.T) {
+	rates := build(t,
+		timelines.NewPeriodValue(days(1, 11), 2.0),
+		timelines.NewPeriodValue(days(11, 21), 3.0),
+	)
+	quantities := build(t,
+		timelines.NewPeriodValue(days(5, 15), 10.0),
+		timelines.NewPeriodValue(days(10, 25), 1.0),
+	)
+
+	costs, err := Multiply(rates, quantities)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []timelines.PeriodValue[float64]{
+		timelines.NewPeriodValue(days(5, 10), 20.0),
+		timelines.NewPeriodValue(days(10, 11), 22.0),
+		timelines.NewPeriodValue(days(11, 15), 33.0),
+		timelines.NewPeriodValue(days(15, 21), 3.0),
+	}, costs.Items)
+}
+
+func TestScale_And_Integrate(t *testing.T) {
+	hourlyRates := build(t,
+		timelines.NewPeriodValue(days(1, 2), 10.0),
+		timelines.NewPeriodValue(days(2, 4), 20.0),
+	)
+
+	scaled := Scale(hourlyRates, 0.5)
+	assertPeriodValues(t, []timelines.PeriodValue[float64]{
+		timelines.NewPeriodValue(days(1, 2), 5.0),
+		timelines.NewPeriodValue(days(2, 4), 10.0),
+	}, scaled.Items)
+
+	if total := Integrate(hourlyRates, time.Hour); total != 24*10+48*20 {
+		t.Errorf("Unexpected integral %v", total)
+	}
+	if total := Integrate(hourlyRates, 24*time.Hour); total != 50 {
+		t.Errorf("Unexpected integral %v", total)
+	}
+}
+
+func TestCumulative(t *testing.T) {
+	sales := build(t,
+		timelines.NewPeriodValue(days(1, 3), 5),
+		timelines.NewPeriodValue(days(2, 4), 1),
+		timelines.NewPeriodValue(days(10, 11), 4),
+	)
+
+	cumulative, err := Cumulative(sales)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []timelines.PeriodValue[int]{
+		timelines.NewPeriodValue(days(1, 2), 5),
+		timelines.NewPeriodValue(days(2, 3), 11),
+		timelines.NewPeriodValue(days(3, 4), 12),
+		timelines.NewPeriodValue(days(10, 11), 16),
+	}, cumulative.Items)
+}
+
+func TestMovingAverage(t *testing.T) {
+	daily := build(t,
+		timelines.NewPeriodValue(days(1, 2), 1.0),
+		timelines.NewPeriodValue(days(2, 3), 2.0),
+		timelines.NewPeriodValue(days(3, 4), 6.0),
+		timelines.NewPeriodValue(days(5, 6), 10.0),
+	)
+
+	averages, err := MovingAverage(daily, 72*time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []float64{1, 1.5, 3, 8}
+	if len(averages.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(averages.Items))
+	}
+	for i, e := range expected {
+		if math.Abs(averages.Items[i].Value-e) > 1e-9 {
+			t.Errorf("Expected average %d to be %v, got %v", i, e, averages.Items[i].Value)
+		}
+	}
+
+	sums, err := MovingWindow(daily, 48*time.Hour, timelines.Sum[float64]())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertPeriodValues(t, []timelines.PeriodValue[float64]{
+		timelines.NewPeriodValue(days(1, 2), 1.0),
+		timelines.NewPeriodValue(days(2, 3), 3.0),
+		timelines.NewPeriodValue(days(3, 4), 8.0),
+		timelines.NewPeriodValue(days(5, 6), 10.0),
+	}, sums.Items)
+}
+
+func TestMovingWindow_ShouldRejectNonPositiveWindow(t *testing.T) {
+	daily := build(t, timelines.NewPeriodValue(days(1, 2), 1.0))
+
+	for _, window := range []time.Duration{0, -time.Hour} {
+		if _, err := MovingAverage(daily, window); err == nil {
+			t.Errorf("Expected an error for window %v", window)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package timelines
+
+import (
+	"time"
+)
+
+// ObservableDisjointTimeline is a DisjointTimeline notifying subscribers of the changes made by each mutation.
+// Like Timeline, it is not safe for concurrent use.
+type ObservableDisjointTimeline[T any] struct {
+	observers[T]
+	timeline DisjointTimeline[T]
+	eq       func(a T, b T) bool
+}
+
+// NewObservableDisjointTimeline creates an ObservableDisjointTimeline holding a copy of the items of given DisjointTimeline,
+// values being compared with eq to compute changes.
+func NewObservableDisjointTimeline[T any](d *DisjointTimeline[T], eq func(a T, b T) bool) *ObservableDisjointTimeline[T] {
+	return &ObservableDisjointTimeline[T]{timeline: DisjointTimeline[T]{timeline: d.Timeline()}, eq: eq}
+}
+
+// mutate applies f, then notifies subscribers of the changes within affected period.
+func (o *ObservableDisjointTimeline[T]) mutate(affected Period, f func(d *DisjointTimeline[T]) error) error {
+	before := Timeline[T]{Items: ClampPeriods(o.timeline.FindIntersects(affected), affected)}
+	if err := f(&o.timeline); err != nil {
+		return err
+	}
+	after := Timeline[T]{Items: ClampPeriods(o.timeline.FindIntersects(affected), affected)}
+
+	changes, err := Diff(before, after, o.eq)
+	if err != nil {
+		return err
+	}
+	o.notify(changes)
+	return nil
+}
+
+// Insert adds a new PeriodValue, returning an error if its period overlaps an existing item.
+func (o *ObservableDisjointTimeline[T]) Insert(period Period, value T) error {
+	return o.mutate(period, func(d *DisjointTimeline[T]) error {
+		return d.Insert(period, value)
+	})
+}
+
+// Set gives value to given period, replacing any existing value on it.
+func (o *ObservableDisjointTimeline[T]) Set(period Period, value T) {
+	_ = o.mutate(period, func(d *DisjointTimeline[T]) error {
+		d.Set(period, value)
+		return nil
+	})
+}
+
+// Delete removes any value on given period, splitting items straddling its bounds.
+func (o *ObservableDisjointTimeline[T]) Delete(period Period) {
+	_ = o.mutate(period, func(d *DisjointTimeline[T]) error {
+		d.Delete(period)
+		return nil
+	})
+}
+
+// Optimize merges all contiguous items having same value according to equalityComparer.
+// Subscribers are only notified when equalityComparer considers equal values that eq does not.
+func (o *ObservableDisjointTimeline[T]) Optimize(equalityComparer func(a T, b T) bool) {
+	_ = o.mutate(Forever(), func(d *DisjointTimeline[T]) error {
+		d.Optimize(equalityComparer)
+		return nil
+	})
+}
+
+// Timeline returns a Timeline holding a copy of all items sorted by the Start date of their Periods.
+func (o *ObservableDisjointTimeline[T]) Timeline() Timeline[T] {
+	return o.timeline.Timeline()
+}
+
+// ValueAt returns the value covering given instant.
+func (o *ObservableDisjointTimeline[T]) ValueAt(instant time.Time) (T, bool) {
+	return o.timeline.ValueAt(instant)
+}
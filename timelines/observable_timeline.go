@@ -0,0 +1,102 @@
+package timelines
+
+import (
+	"time"
+)
+
+// observers holds the subscribers of an observable timeline.
+type observers[T any] struct {
+	subscribers []subscriber[T]
+	lastID      int
+}
+
+type subscriber[T any] struct {
+	id int
+	f  func(changes []Change[T])
+}
+
+// Subscribe registers f to be called with the changes of each mutation actually changing the timeline,
+// right after the mutation. It returns a function cancelling the subscription.
+func (o *observers[T]) Subscribe(f func(changes []Change[T])) (unsubscribe func()) {
+	o.lastID++
+	id := o.lastID
+	o.subscribers = append(o.subscribers, subscriber[T]{id: id, f: f})
+
+	return func() {
+		for i, s := range o.subscribers {
+			if s.id == id {
+				o.subscribers = append(o.subscribers[:i:i], o.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notify calls subscribers with changes, unless there are none.
+func (o *observers[T]) notify(changes []Change[T]) {
+	if len(changes) == 0 {
+		return
+	}
+
+	for _, s := range o.subscribers {
+		s.f(changes)
+	}
+}
+
+// ObservableTimeline is a Timeline notifying subscribers of the items changed by each mutation.
+// As items may overlap, changes are given per item: an added item is reported as inserted,
+// items merged by Optimize as removed and their merge as inserted.
+// Like Timeline, it is not safe for concurrent use.
+type ObservableTimeline[T any] struct {
+	observers[T]
+	timeline Timeline[T]
+}
+
+// NewObservableTimeline creates an ObservableTimeline holding a copy of the items of given Timeline,
+// which must be sorted.
+func NewObservableTimeline[T any](t Timeline[T]) *ObservableTimeline[T] {
+	return &ObservableTimeline[T]{timeline: Timeline[T]{Items: append([]PeriodValue[T]{}, t.Items...)}}
+}
+
+// Add adds a new PeriodValue, notifying subscribers of its insertion.
+func (o *ObservableTimeline[T]) Add(period Period, value T) {
+	o.timeline.Add(period, value)
+	o.notify([]Change[T]{{Kind: ChangeInserted, Period: period, New: value}})
+}
+
+// Optimize merges all contiguous items having same value according to equalityComparer.
+func (o *ObservableTimeline[T]) Optimize(equalityComparer func(a T, b T) bool) {
+	before := o.timeline.Items
+	o.timeline = o.timeline.Optimize(equalityComparer)
+
+	// Optimize keeps items in order, each one being an item of before or the merge of consecutive ones
+	var changes []Change[T]
+	i := 0
+	for _, pv := range o.timeline.Items {
+		if pv.Period.Equal(before[i].Period) {
+			i++
+			continue
+		}
+
+		for ; i < len(before); i++ {
+			changes = append(changes, Change[T]{Kind: ChangeRemoved, Period: before[i].Period, Old: before[i].Value})
+			if before[i].Period.End.Equal(pv.Period.End) {
+				i++
+				break
+			}
+		}
+		changes = append(changes, Change[T]{Kind: ChangeInserted, Period: pv.Period, New: pv.Value})
+	}
+
+	o.notify(changes)
+}
+
+// Timeline returns a Timeline holding a copy of all items sorted by the Start date of their Periods.
+func (o *ObservableTimeline[T]) Timeline() Timeline[T] {
+	return Timeline[T]{Items: append([]PeriodValue[T]{}, o.timeline.Items...)}
+}
+
+// ValuesAt returns values of all items covering given instant, ordered by period start.
+func (o *ObservableTimeline[T]) ValuesAt(instant time.Time) []T {
+	return o.timeline.ValuesAt(instant)
+}
@@ -0,0 +1,60 @@
+package timelines
+
+import (
+	"slices"
+	"sort"
+)
+
+// Set gives value to given period, carving it out of existing items so that the Timeline keeps having no overlaps.
+// Timeline items must be sorted and must not overlap.
+func (t *Timeline[T]) Set(period Period, value T) {
+	if period.IsEmpty() {
+		return
+	}
+	t.replace(period, []PeriodValue[T]{NewPeriodValue(period, value)})
+}
+
+// Delete removes any coverage of given period, splitting items straddling its bounds.
+// Timeline items must be sorted and must not overlap.
+func (t *Timeline[T]) Delete(period Period) {
+	if period.IsEmpty() {
+		return
+	}
+	t.replace(period, nil)
+}
+
+// searchOverlapping returns the range of items overlapping given period, assuming items are sorted and do not overlap.
+func (t *Timeline[T]) searchOverlapping(period Period) (first int, last int) {
+	// items do not overlap, so their ends are sorted too
+	first = sort.Search(len(t.Items), func(i int) bool {
+		return t.Items[i].Period.End.After(period.Start)
+	})
+	last = first + sort.Search(len(t.Items)-first, func(i int) bool {
+		return !t.Items[first+i].Period.Start.Before(period.End)
+	})
+	return first, last
+}
+
+// replace replaces coverage of given period by items, which must be within period.
+func (t *Timeline[T]) replace(period Period, items []PeriodValue[T]) {
+	first, last := t.searchOverlapping(period)
+
+	var before, after []PeriodValue[T]
+	for _, pv := range t.Items[first:last] {
+		for part := range pv.Period.SplitFromPeriodSeq(period) {
+			switch {
+			case !part.End.After(period.Start):
+				before = append(before, NewPeriodValue(part, pv.Value))
+			case !part.Start.Before(period.End):
+				after = append(after, NewPeriodValue(part, pv.Value))
+			}
+		}
+	}
+
+	replacement := make([]PeriodValue[T], 0, len(before)+len(items)+len(after))
+	replacement = append(replacement, before...)
+	replacement = append(replacement, items...)
+	replacement = append(replacement, after...)
+
+	t.Items = slices.Replace(t.Items, first, last, replacement...)
+}
@@ -0,0 +1,77 @@
+package timelines
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestTimeline_Set_ShouldCarveExistingItems(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 2, 2).
+		AddMonth(2024, 4, 4).
+		Build()
+
+	timeline.Set(Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}, 9)
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}, Value: 9},
+		{Period: Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 3, 1)}, Value: 2},
+		{Period: Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 5, 1)}, Value: 4},
+	}, timeline.Items)
+
+	// inside a gap
+	timeline.Set(Period{Start: DateOnly(2024, 3, 10), End: DateOnly(2024, 3, 20)}, 3)
+	// covering several items
+	timeline.Set(Period{Start: DateOnly(2023, 12, 1), End: DateOnly(2024, 3, 15)}, 0)
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2023, 12, 1), End: DateOnly(2024, 3, 15)}, Value: 0},
+		{Period: Period{Start: DateOnly(2024, 3, 15), End: DateOnly(2024, 3, 20)}, Value: 3},
+		{Period: Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 5, 1)}, Value: 4},
+	}, timeline.Items)
+}
+
+func TestTimeline_Delete_ShouldSplitStraddlingItems(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 2, 2).
+		Build()
+
+	timeline.Delete(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)})
+	timeline.Delete(Period{Start: DateOnly(2024, 1, 25), End: DateOnly(2024, 2, 5)})
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 25)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 2, 5), End: DateOnly(2024, 3, 1)}, Value: 2},
+	}, timeline.Items)
+
+	timeline.Delete(Forever())
+	if len(timeline.Items) != 0 {
+		t.Errorf("Expected empty timeline, got %v", timeline.Items)
+	}
+}
+
+func TestTimeline_Set_ShouldMatchLastWins(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	origin := DateOnly(2024, 1, 1)
+	randomPeriod := func() Period {
+		start := origin.Add(time.Duration(r.Int63n(int64(60 * 24 * time.Hour))))
+		return Period{Start: start, End: start.Add(time.Minute + time.Duration(r.Int63n(int64(10*24*time.Hour))))}
+	}
+
+	timeline := NewTimeline[int]()
+	expected := NewTimeline[int]()
+	for i := 0; i < 200; i++ {
+		period := randomPeriod()
+		timeline.Set(period, i)
+		if err := expected.AddWithStrategy(period, i, LastWins[int]()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	assertPeriodValues(t, expected.Items, timeline.Items)
+}
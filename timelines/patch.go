@@ -0,0 +1,87 @@
+package timelines
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Patch is a serializable set of changes between two versions of a timeline, on disjoint sliced periods.
+// Added and Changed hold the new values, Removed holds the values removed
+// and Previous the values replaced by Changed, period by period.
+type Patch[T any] struct {
+	Added    []PeriodValue[T] `json:"added"`
+	Removed  []PeriodValue[T] `json:"removed"`
+	Changed  []PeriodValue[T] `json:"changed"`
+	Previous []PeriodValue[T] `json:"previous"`
+}
+
+// NewPatch returns the Patch turning timeline a into timeline b, values being compared with eq.
+// Timeline items must be sorted and must not overlap.
+func NewPatch[T any](a Timeline[T], b Timeline[T], eq func(a T, b T) bool) (Patch[T], error) {
+	changes, err := Diff(a, b, eq)
+	if err != nil {
+		return Patch[T]{}, err
+	}
+
+	patch := Patch[T]{Added: []PeriodValue[T]{}, Removed: []PeriodValue[T]{}, Changed: []PeriodValue[T]{}, Previous: []PeriodValue[T]{}}
+	for _, c := range changes {
+		switch c.Kind {
+		case ChangeInserted:
+			patch.Added = append(patch.Added, NewPeriodValue(c.Period, c.New))
+		case ChangeRemoved:
+			patch.Removed = append(patch.Removed, NewPeriodValue(c.Period, c.Old))
+		case ChangeModified:
+			patch.Changed = append(patch.Changed, NewPeriodValue(c.Period, c.New))
+			patch.Previous = append(patch.Previous, NewPeriodValue(c.Period, c.Old))
+		}
+	}
+
+	return patch, nil
+}
+
+// IsEmpty checks if the patch has no change.
+func (p *Patch[T]) IsEmpty() bool {
+	return len(p.Added) == 0 && len(p.Removed) == 0 && len(p.Changed) == 0
+}
+
+// Apply returns another Timeline with the changes of patch applied. It returns an error if the patch
+// does not match the timeline: added periods must not be covered, removed and changed ones must be covered
+// with the removed and previous values, compared with eq.
+// Timeline items must be sorted and must not overlap.
+func (t *Timeline[T]) Apply(patch Patch[T], eq func(a T, b T) bool) (Timeline[T], error) {
+	if len(patch.Changed) != len(patch.Previous) {
+		return Timeline[T]{}, fmt.Errorf("patch has %d changed values but %d previous ones", len(patch.Changed), len(patch.Previous))
+	}
+	for i, pv := range patch.Changed {
+		if !pv.Period.Equal(patch.Previous[i].Period) {
+			return Timeline[T]{}, fmt.Errorf("patch changes period %v but has previous value for %v", pv.Period, patch.Previous[i].Period)
+		}
+	}
+
+	for _, pv := range patch.Added {
+		if first, last := t.searchOverlapping(pv.Period); first < last {
+			return Timeline[T]{}, fmt.Errorf("patch adds period %v already covered", pv.Period)
+		}
+	}
+	for _, pv := range slices.Concat(patch.Removed, patch.Previous) {
+		if !t.Covers(pv.Period) {
+			return Timeline[T]{}, fmt.Errorf("patch changes period %v not covered", pv.Period)
+		}
+		first, last := t.searchOverlapping(pv.Period)
+		for _, item := range t.Items[first:last] {
+			if !eq(item.Value, pv.Value) {
+				return Timeline[T]{}, fmt.Errorf("patch expects %v on period %v, got %v", pv.Value, pv.Period, item.Value)
+			}
+		}
+	}
+
+	result := Timeline[T]{Items: slices.Clone(t.Items)}
+	for _, pv := range patch.Removed {
+		result.Delete(pv.Period)
+	}
+	for _, pv := range slices.Concat(patch.Changed, patch.Added) {
+		result.Set(pv.Period, pv.Value)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,140 @@
+package timelines
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// randomDisjointTimeline returns a timeline without overlaps having few distinct values.
+func randomDisjointTimeline(r *rand.Rand, count int) Timeline[int] {
+	origin := DateOnly(2024, 1, 1)
+	timeline := NewTimeline[int]()
+
+	for i := 0; i < count; i++ {
+		start := origin.Add(time.Duration(r.Intn(90)) * 24 * time.Hour)
+		end := start.Add(time.Duration(1+r.Intn(15)) * 24 * time.Hour)
+		if r.Intn(4) == 0 {
+			timeline.Delete(Period{Start: start, End: end})
+		} else {
+			timeline.Set(Period{Start: start, End: end}, r.Intn(3))
+		}
+	}
+
+	return timeline
+}
+
+func TestNewPatch_And_Apply(t *testing.T) {
+	before, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 2, 2).
+		Build()
+	after, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddPeriod(DateOnly(2024, 2, 1), DateOnly(2024, 2, 10), 3).
+		AddMonth(2024, 4, 4).
+		Build()
+
+	patch, err := NewPatch(before, after, equalInts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{{Period: Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 5, 1)}, Value: 4}}, patch.Added)
+	assertPeriodValues(t, []PeriodValue[int]{{Period: Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 3, 1)}, Value: 2}}, patch.Removed)
+	assertPeriodValues(t, []PeriodValue[int]{{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 10)}, Value: 3}}, patch.Changed)
+	assertPeriodValues(t, []PeriodValue[int]{{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 10)}, Value: 2}}, patch.Previous)
+
+	applied, err := before.Apply(patch, equalInts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertPeriodValues(t, after.Items, applied.Items)
+
+	// before is unchanged, and the patch no longer matches after
+	if len(before.Items) != 2 || before.Items[1].Value != 2 {
+		t.Errorf("Expected timeline to be unchanged, got %v", before.Items)
+	}
+	if _, err := after.Apply(patch, equalInts); err == nil {
+		t.Errorf("Expected an error applying the patch twice")
+	}
+}
+
+func TestTimeline_Apply_ShouldRejectStaleBase(t *testing.T) {
+	before, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 2, 2).
+		Build()
+	after, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 5).
+		Build()
+
+	patch, err := NewPatch(before, after, equalInts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		base Timeline[int]
+	}{
+		{name: "changed value differs", base: Timeline[int]{Items: []PeriodValue[int]{
+			{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, Value: 9},
+			{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, Value: 2},
+		}}},
+		{name: "removed value differs", base: Timeline[int]{Items: []PeriodValue[int]{
+			{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, Value: 1},
+			{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 15)}, Value: 2},
+			{Period: Period{Start: DateOnly(2024, 2, 15), End: DateOnly(2024, 3, 1)}, Value: 7},
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result, err := tt.base.Apply(patch, equalInts); err == nil {
+				t.Errorf("Expected an error applying the patch to a stale base, got %v", result.Items)
+			}
+		})
+	}
+}
+
+func TestPatch_ShouldRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+
+	for round := 0; round < 200; round++ {
+		a := randomDisjointTimeline(r, r.Intn(20))
+		b := randomDisjointTimeline(r, r.Intn(20))
+
+		patch, err := NewPatch(a, b, equalInts)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		data, err := json.Marshal(patch)
+		if err != nil {
+			t.Fatalf("Could not marshal patch: %v", err)
+		}
+		var decoded Patch[int]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Could not unmarshal patch %s: %v", data, err)
+		}
+
+		applied, err := a.Apply(decoded, equalInts)
+		if err != nil {
+			t.Fatalf("Could not apply patch %s: %v", data, err)
+		}
+
+		remaining, err := Diff(applied, b, equalInts)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(remaining) != 0 {
+			t.Fatalf("Expected patched timeline to equal target, remaining changes %v", remaining)
+		}
+
+		if empty, _ := NewPatch(b, b, equalInts); !empty.IsEmpty() {
+			t.Fatalf("Expected an empty patch between identical timelines, got %v", empty)
+		}
+	}
+}
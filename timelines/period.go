@@ -1,15 +1,47 @@
 package timelines
 
 import (
+	"context"
 	"errors"
+	"math"
 	"time"
 )
 
+// unixToInternal is the number of seconds between year 1 and 1970, the offset used by time.Unix.
+const unixToInternal int64 = 62135596800
+
+var (
+	// NegativeInfinity is the earliest representable instant, used as start of periods unbounded in the past.
+	NegativeInfinity = time.Unix(math.MinInt64, 0).UTC()
+
+	// PositiveInfinity is the latest representable instant, used as end of periods unbounded in the future.
+	PositiveInfinity = time.Unix(math.MaxInt64-unixToInternal, 999999999).UTC()
+)
+
+// Period is a time range from Start to End, half-open [Start, End) unless StartBound or EndBound tell otherwise.
+// Bounds are honored when checking instants and periods against each other, but timeline operations
+// slice items as half-open ranges of instants.
 type Period struct {
 	Start time.Time
 	End   time.Time
+	// StartBound tells if Start belongs to the period, DefaultBound meaning Inclusive.
+	StartBound Bound
+	// EndBound tells if End belongs to the period, DefaultBound meaning Exclusive.
+	EndBound Bound
 }
 
+// Bound tells if a boundary instant belongs to a period.
+type Bound int
+
+const (
+	// DefaultBound is the bound of half-open periods: an inclusive start and an exclusive end.
+	DefaultBound Bound = iota
+	// Inclusive means the boundary instant belongs to the period.
+	Inclusive
+	// Exclusive means the boundary instant does not belong to the period.
+	Exclusive
+)
+
 func NewPeriod(start, end time.Time) (*Period, error) {
 	if !end.After(start) {
 		return nil, errors.New("end date must be after start date")
@@ -17,6 +49,42 @@ func NewPeriod(start, end time.Time) (*Period, error) {
 	return &Period{Start: start, End: end}, nil
 }
 
+// NewPeriodWithBounds creates a Period from bounds having explicit inclusivity, kept in StartBound and EndBound.
+// End must be after start, or equal to it when both bounds are inclusive. Bounds of infinite sides are ignored.
+func NewPeriodWithBounds(start time.Time, startBound Bound, end time.Time, endBound Bound) (*Period, error) {
+	period := &Period{Start: start, End: end}
+	if startBound == Exclusive && !isInfinite(start) {
+		period.StartBound = Exclusive
+	}
+	if endBound == Inclusive && !isInfinite(end) {
+		period.EndBound = Inclusive
+	}
+
+	if period.IsEmpty() {
+		return nil, errors.New("end date must be after start date")
+	}
+	return period, nil
+}
+
+// NewPeriodFrom creates a Period starting at given instant and never ending.
+func NewPeriodFrom(start time.Time) (*Period, error) {
+	return NewPeriod(start, PositiveInfinity)
+}
+
+// NewPeriodUntil creates a Period unbounded in the past and ending at given instant.
+func NewPeriodUntil(end time.Time) (*Period, error) {
+	return NewPeriod(NegativeInfinity, end)
+}
+
+// Forever returns a Period unbounded on both sides.
+func Forever() Period {
+	return Period{Start: NegativeInfinity, End: PositiveInfinity}
+}
+
+func isInfinite(t time.Time) bool {
+	return t.Equal(NegativeInfinity) || t.Equal(PositiveInfinity)
+}
+
 func Empty() Period {
 	return Period{Start: time.Time{}, End: time.Time{}}
 }
@@ -24,7 +92,13 @@ func Empty() Period {
 // Day returns a Period for the given year, month and day.
 // The start is given day, and the end is the next day (exclusive).
 func Day(year int, month int, day int) (*Period, error) {
-	start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return DayIn(year, month, day, time.UTC)
+}
+
+// DayIn returns a Period for the given year, month and day in given location.
+// The start is local midnight, so the period lasts 23 or 25 hours on DST transition days.
+func DayIn(year int, month int, day int, loc *time.Location) (*Period, error) {
+	start := DateOnlyIn(year, month, day, loc)
 
 	nextDay := start.AddDate(0, 0, 1)
 
@@ -32,13 +106,31 @@ func Day(year int, month int, day int) (*Period, error) {
 }
 
 func DateOnly(year int, month int, day int) time.Time {
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return DateOnlyIn(year, month, day, time.UTC)
+}
+
+// DateOnlyIn returns midnight of given date in given location, UTC if loc is nil.
+func DateOnlyIn(year int, month int, day int, loc *time.Location) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, locationOrUTC(loc))
+}
+
+// locationOrUTC returns loc, or UTC if loc is nil.
+func locationOrUTC(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
 }
 
 // Month returns a Period for the given year and month.
 // The start is the first day of the month, and the end is the first day of the next month (exclusive).
 func Month(year int, month int) (*Period, error) {
-	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	return MonthIn(year, month, time.UTC)
+}
+
+// MonthIn returns a Period for the given year and month, starting and ending at local midnight in given location.
+func MonthIn(year int, month int, loc *time.Location) (*Period, error) {
+	start := DateOnlyIn(year, month, 1, loc)
 
 	nextMonth := start.AddDate(0, 1, 0)
 
@@ -48,73 +140,134 @@ func Month(year int, month int) (*Period, error) {
 // Year returns a Period for the given year.
 // The start is the first day of the year, and the end is the first day of the next year (exclusive).
 func Year(year int) (*Period, error) {
-	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return YearIn(year, time.UTC)
+}
+
+// YearIn returns a Period for the given year, starting and ending at local midnight in given location.
+func YearIn(year int, loc *time.Location) (*Period, error) {
+	start := DateOnlyIn(year, 1, 1, loc)
 
 	nextYear := start.AddDate(1, 0, 0)
 
 	return NewPeriod(start, nextYear)
 }
 
-// Equal compares two periods.
+// Equal compares two periods, including their bounds.
 func (p Period) Equal(other Period) bool {
-	return p.Start.Equal(other.Start) && p.End.Equal(other.End)
+	return p.Start.Equal(other.Start) && p.End.Equal(other.End) &&
+		p.IncludesStart() == other.IncludesStart() && p.IncludesEnd() == other.IncludesEnd()
+}
+
+// IncludesStart checks if Start belongs to the period.
+func (p *Period) IncludesStart() bool {
+	return p.StartBound != Exclusive
+}
+
+// IncludesEnd checks if End belongs to the period.
+func (p *Period) IncludesEnd() bool {
+	return p.EndBound == Inclusive
+}
+
+// startsBeforeEndOf checks if the period starts before other ends, sharing at least their bound when equal.
+func (p *Period) startsBeforeEndOf(other Period) bool {
+	return p.Start.Before(other.End) || (p.Start.Equal(other.End) && p.IncludesStart() && other.IncludesEnd())
 }
 
 // Duration returns duration of given period.
+// Periods having an infinite bound, or lasting more than 292 years, saturate to the maximum time.Duration.
 func (p *Period) Duration() time.Duration {
 	return p.End.Sub(p.Start)
 }
 
-// Contains check if a periods contains another one.
+// HasInfiniteStart checks if the period is unbounded in the past.
+func (p *Period) HasInfiniteStart() bool {
+	return p.Start.Equal(NegativeInfinity)
+}
+
+// HasInfiniteEnd checks if the period is unbounded in the future.
+func (p *Period) HasInfiniteEnd() bool {
+	return p.End.Equal(PositiveInfinity)
+}
+
+// IsBounded checks if both start and end of the period are finite.
+func (p *Period) IsBounded() bool {
+	return !p.HasInfiniteStart() && !p.HasInfiniteEnd()
+}
+
+// LastInstant returns the last instant belonging to the period, so that it can be expressed as a closed range.
+func (p *Period) LastInstant() time.Time {
+	if p.HasInfiniteEnd() || p.IncludesEnd() {
+		return p.End
+	}
+	return p.End.Add(-time.Nanosecond)
+}
+
+// Contains checks if given instant is within the period, according to its bounds.
 func (p *Period) Contains(t time.Time) bool {
-	return (t.After(p.Start) || t.Equal(p.Start)) && (t.Before(p.End) || t.Equal(p.End))
+	return (t.After(p.Start) || (t.Equal(p.Start) && p.IncludesStart())) &&
+		(t.Before(p.End) || (t.Equal(p.End) && p.IncludesEnd()))
 }
 
 // ContainsPeriod checks if the current period fully contains another period.
 func (p *Period) ContainsPeriod(other Period) bool {
-	return (p.Start.Before(other.Start) || p.Start.Equal(other.Start)) &&
-		(p.End.After(other.End) || p.End.Equal(other.End))
+	return (p.Start.Before(other.Start) || (p.Start.Equal(other.Start) && (p.IncludesStart() || !other.IncludesStart()))) &&
+		(p.End.After(other.End) || (p.End.Equal(other.End) && (p.IncludesEnd() || !other.IncludesEnd())))
 }
 
-// Intersects checks if two periods overlap.
+// Intersects checks if two periods overlap, that is share at least an instant.
 func (p *Period) Intersects(other Period) bool {
-	return p.Start.Before(other.End) && p.End.After(other.Start)
+	return p.startsBeforeEndOf(other) && other.startsBeforeEndOf(*p)
 }
 
 // Split a period using given function.
+// Splitting stops if the function does not return an instant after current one,
+// which only SplitSeq and SplitContext report as ErrStepNotAdvancing.
+// The caller must read the channel until it is closed, otherwise prefer SplitSeq or SplitContext.
 func (p *Period) Split(f func(current time.Time) time.Time) <-chan Period {
-	ch := make(chan Period)
-
-	go func() {
-		defer close(ch)
-
-		current := p.Start
-		for current.Before(p.End) {
-			next := f(current)
-			ch <- Period{Start: current, End: next}
-			current = next
-		}
-	}()
-
+	ch, _ := p.SplitContext(context.Background(), f)
 	return ch
 }
 
 // SplitByDays returns periods for each days in given period.
 func (p *Period) SplitByDays() <-chan Period {
-	return p.Split(func(current time.Time) time.Time { return current.AddDate(0, 0, 1) })
+	return p.Split(nextDay)
 }
 
 // SplitByMonths returns periods for each months in given period.
 func (p *Period) SplitByMonths() <-chan Period {
-	return p.Split(func(current time.Time) time.Time { return current.AddDate(0, 1, 0) })
+	return p.Split(nextMonth)
+}
+
+// SplitByDaysIn returns periods cut at each local midnight of given location.
+// First and last periods are partial days when period does not start or end at midnight.
+func (p *Period) SplitByDaysIn(loc *time.Location) <-chan Period {
+	loc = locationOrUTC(loc)
+	return p.Split(func(current time.Time) time.Time {
+		year, month, day := current.In(loc).Date()
+		return minTime(time.Date(year, month, day+1, 0, 0, 0, 0, loc), p.End)
+	})
+}
+
+// SplitByMonthsIn returns periods cut at the first local midnight of each month in given location.
+// First and last periods are partial months when period does not start or end on a month boundary.
+func (p *Period) SplitByMonthsIn(loc *time.Location) <-chan Period {
+	loc = locationOrUTC(loc)
+	return p.Split(func(current time.Time) time.Time {
+		year, month, _ := current.In(loc).Date()
+		return minTime(time.Date(year, month+1, 1, 0, 0, 0, 0, loc), p.End)
+	})
 }
 
+// Before checks if the period ends before the other one starts.
+// Unless both bounds are inclusive, a period meeting the other one is before it.
 func (p *Period) Before(other Period) bool {
-	return p.End.Before(other.Start) || p.End.Equal(other.Start)
+	return !other.startsBeforeEndOf(*p)
 }
 
+// After checks if the period starts after the other one ends.
+// Unless both bounds are inclusive, a period met by the other one is after it.
 func (p *Period) After(other Period) bool {
-	return p.Start.After(other.End) || p.Start.Equal(other.End)
+	return !p.startsBeforeEndOf(other)
 }
 
 // Helper function to find the minimum of two times
@@ -135,57 +288,27 @@ func maxTime(t1, t2 time.Time) time.Time {
 
 // SplitFromPeriod returns a split of periods intersecting with given period
 func (p *Period) SplitFromPeriod(period Period) <-chan Period {
-	ch := make(chan Period)
-
-	go func() {
-		defer close(ch)
-
-		if !p.Intersects(period) {
-			return
-		}
-
-		// before intersecting part
-		if p.Start.Before(period.Start) {
-			ch <- Period{Start: p.Start, End: period.Start}
-		}
-
-		// intersecting part
-		overlapStart := maxTime(p.Start, period.Start)
-		overlapEnd := minTime(p.End, period.End)
-		ch <- Period{
-			Start: overlapStart,
-			End:   overlapEnd,
-		}
-
-		// after intersecting part
-		if p.End.After(period.End) {
-			ch <- Period{
-				Start: period.End,
-				End:   p.End,
-			}
-		}
-	}()
-
-	return ch
+	return toChannel(p.SplitFromPeriodSeq(period))
 }
 
 // IsEmpty checks if period is empty
 func (p *Period) IsEmpty() bool {
-	return !p.Start.Before(p.End)
+	return !p.Start.Before(p.End) && !(p.Start.Equal(p.End) && p.IncludesStart() && p.IncludesEnd())
 }
 
 func (p *Period) Clamp(limit Period) (Period, error) {
-	if p.Intersects(limit) {
-		start := maxTime(limit.Start, p.Start)
-		end := minTime(limit.End, p.End)
-		period, err := NewPeriod(start, end)
-		if err != nil {
-			return Empty(), err
-		}
-		return *period, nil
+	if !p.Intersects(limit) {
+		return Empty(), errors.New("limit is outside")
 	}
 
-	return Empty(), errors.New("limit is outside")
+	clamped := *p
+	if limit.Start.After(p.Start) || (limit.Start.Equal(p.Start) && !limit.IncludesStart()) {
+		clamped.Start, clamped.StartBound = limit.Start, limit.StartBound
+	}
+	if limit.End.Before(p.End) || (limit.End.Equal(p.End) && !limit.IncludesEnd()) {
+		clamped.End, clamped.EndBound = limit.End, limit.EndBound
+	}
+	return clamped, nil
 }
 
 // IsContiguous checks if the other Period is contiguous
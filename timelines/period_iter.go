@@ -0,0 +1,149 @@
+package timelines
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"time"
+)
+
+// ErrStepNotAdvancing is reported when a split function does not return an instant after current one.
+var ErrStepNotAdvancing = errors.New("split function must return an instant after current one")
+
+func nextDay(current time.Time) time.Time {
+	return current.AddDate(0, 0, 1)
+}
+
+func nextMonth(current time.Time) time.Time {
+	return current.AddDate(0, 1, 0)
+}
+
+// split yields periods cut by f until end of period or until yield returns false.
+func (p Period) split(f func(current time.Time) time.Time, yield func(Period) bool) error {
+	current := p.Start
+	for current.Before(p.End) {
+		next := f(current)
+		if !next.After(current) {
+			return ErrStepNotAdvancing
+		}
+
+		if !yield(Period{Start: current, End: next}) {
+			return nil
+		}
+		current = next
+	}
+
+	return nil
+}
+
+// SplitSeq returns an iterator over periods cut using given function, each paired with a nil error.
+// If the function does not return an instant after current one, iteration ends with ErrStepNotAdvancing.
+func (p *Period) SplitSeq(f func(current time.Time) time.Time) iter.Seq2[Period, error] {
+	period := *p
+	return func(yield func(Period, error) bool) {
+		err := period.split(f, func(current Period) bool {
+			return yield(current, nil)
+		})
+		if err != nil {
+			yield(Empty(), err)
+		}
+	}
+}
+
+// SplitByDaysSeq returns an iterator over periods for each days in given period.
+func (p *Period) SplitByDaysSeq() iter.Seq2[Period, error] {
+	return p.SplitSeq(nextDay)
+}
+
+// SplitByMonthsSeq returns an iterator over periods for each months in given period.
+func (p *Period) SplitByMonthsSeq() iter.Seq2[Period, error] {
+	return p.SplitSeq(nextMonth)
+}
+
+// SplitFromPeriodSeq returns an iterator over the split of periods intersecting with given period.
+func (p *Period) SplitFromPeriodSeq(period Period) iter.Seq[Period] {
+	current := *p
+	return func(yield func(Period) bool) {
+		if !current.Intersects(period) {
+			return
+		}
+
+		// before intersecting part
+		if current.Start.Before(period.Start) {
+			if !yield(Period{Start: current.Start, End: period.Start}) {
+				return
+			}
+		}
+
+		// intersecting part
+		overlapStart := maxTime(current.Start, period.Start)
+		overlapEnd := minTime(current.End, period.End)
+		if !yield(Period{Start: overlapStart, End: overlapEnd}) {
+			return
+		}
+
+		// after intersecting part
+		if current.End.After(period.End) {
+			yield(Period{Start: period.End, End: current.End})
+		}
+	}
+}
+
+// SplitContext cuts a period using given function, sending periods on the returned channel
+// until the period is fully split or ctx is done. The error channel then receives ctx.Err() or
+// ErrStepNotAdvancing if splitting did not complete, and both channels are closed.
+func (p *Period) SplitContext(ctx context.Context, f func(current time.Time) time.Time) (<-chan Period, <-chan error) {
+	ch := make(chan Period)
+	errs := make(chan error, 1)
+	period := *p
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		var cancelled error
+		err := period.split(f, func(current Period) bool {
+			select {
+			case ch <- current:
+				return true
+			case <-ctx.Done():
+				cancelled = ctx.Err()
+				return false
+			}
+		})
+
+		if err == nil {
+			err = cancelled
+		}
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return ch, errs
+}
+
+// SplitByDaysContext is SplitContext cutting given period by days.
+func (p *Period) SplitByDaysContext(ctx context.Context) (<-chan Period, <-chan error) {
+	return p.SplitContext(ctx, nextDay)
+}
+
+// SplitByMonthsContext is SplitContext cutting given period by months.
+func (p *Period) SplitByMonthsContext(ctx context.Context) (<-chan Period, <-chan error) {
+	return p.SplitContext(ctx, nextMonth)
+}
+
+// toChannel sends all periods of seq on the returned channel, which is closed at the end.
+func toChannel(seq iter.Seq[Period]) <-chan Period {
+	ch := make(chan Period)
+
+	go func() {
+		defer close(ch)
+
+		for period := range seq {
+			ch <- period
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,164 @@
+package timelines
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestPeriod_SplitByDaysSeq_ShouldMatchChannel(t *testing.T) {
+	year, _ := Year(2024)
+
+	var fromSeq []Period
+	for d, err := range year.SplitByDaysSeq() {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		fromSeq = append(fromSeq, d)
+	}
+
+	i := 0
+	for d := range year.SplitByDays() {
+		if !d.Equal(fromSeq[i]) {
+			t.Errorf("Expected %v, got %v", d, fromSeq[i])
+		}
+		i++
+	}
+
+	if len(fromSeq) != 366 || i != 366 {
+		t.Errorf("Expected 366 days, got %d and %d", len(fromSeq), i)
+	}
+}
+
+func TestPeriod_SplitByMonthsSeq_ShouldStopEarly(t *testing.T) {
+	forever, _ := NewPeriodFrom(DateOnly(2024, 1, 1))
+
+	count := 0
+	var last Period
+	for m := range forever.SplitByMonthsSeq() {
+		count++
+		last = m
+		if count == 3 {
+			break
+		}
+	}
+
+	march, _ := Month(2024, 3)
+	if !last.Equal(*march) {
+		t.Errorf("Expected %v, got %v", *march, last)
+	}
+}
+
+func TestPeriod_SplitSeq_ShouldStopWhenStepDoesNotAdvance(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	tests := []struct {
+		name string
+		step func(current time.Time) time.Time
+	}{
+		{name: "same instant", step: func(current time.Time) time.Time { return current }},
+		{name: "going backward", step: func(current time.Time) time.Time { return current.AddDate(0, 0, -1) }},
+		{name: "stuck after first day", step: func(current time.Time) time.Time { return minTime(nextDay(current), DateOnly(2024, 1, 2)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			for _, err = range january.SplitSeq(tt.step) {
+				if err != nil {
+					break
+				}
+			}
+
+			if !errors.Is(err, ErrStepNotAdvancing) {
+				t.Errorf("Expected ErrStepNotAdvancing, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPeriod_SplitFromPeriodSeq(t *testing.T) {
+	feb2024, _ := Month(2024, 2)
+	day, _ := Day(2024, 2, 15)
+
+	var results []Period
+	for p := range feb2024.SplitFromPeriodSeq(*day) {
+		results = append(results, p)
+		break
+	}
+
+	if len(results) != 1 || !results[0].Equal(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 15)}) {
+		t.Errorf("Expected only the part before intersection, got %v", results)
+	}
+}
+
+func TestPeriod_SplitContext_ShouldReportNonAdvancingStep(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	periods, errs := january.SplitContext(context.Background(), func(current time.Time) time.Time {
+		if current.Day() == 10 {
+			return current
+		}
+		return current.AddDate(0, 0, 1)
+	})
+
+	count := 0
+	for range periods {
+		count++
+	}
+
+	if count != 9 {
+		t.Errorf("Expected 9 periods, got %d", count)
+	}
+
+	if err := <-errs; !errors.Is(err, ErrStepNotAdvancing) {
+		t.Errorf("Expected ErrStepNotAdvancing, got %v", err)
+	}
+}
+
+func TestPeriod_SplitByDaysContext_ShouldStopOnCancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	forever, _ := NewPeriodFrom(DateOnly(2024, 1, 1))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	periods, errs := forever.SplitByDaysContext(ctx)
+	<-periods
+	<-periods
+	cancel()
+
+	for range periods {
+	}
+
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	for i := 0; i < 100 && runtime.NumGoroutine() > goroutines; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if runtime.NumGoroutine() > goroutines {
+		t.Errorf("Expected split goroutine to exit, got %d goroutines instead of %d", runtime.NumGoroutine(), goroutines)
+	}
+}
+
+func TestPeriod_SplitByMonthsContext_ShouldCompleteWithoutError(t *testing.T) {
+	year, _ := Year(2024)
+
+	periods, errs := year.SplitByMonthsContext(context.Background())
+
+	count := 0
+	for range periods {
+		count++
+	}
+
+	if count != 12 {
+		t.Errorf("Expected 12 months, got %d", count)
+	}
+
+	if err, ok := <-errs; ok {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
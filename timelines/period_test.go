@@ -1,6 +1,7 @@
 package timelines
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -380,3 +381,274 @@ func TestPeriod_Clamp(t *testing.T) {
 		})
 	}
 }
+
+func TestDayIn_ShouldFollowDaylightSavingTime(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Could not load location: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		day      int
+		month    int
+		expected time.Duration
+	}{
+		{name: "spring forward", month: 3, day: 31, expected: 23 * time.Hour},
+		{name: "fall back", month: 10, day: 27, expected: 25 * time.Hour},
+		{name: "regular day", month: 6, day: 1, expected: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, err := DayIn(2024, tt.month, tt.day, paris)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if day.Duration() != tt.expected {
+				t.Errorf("Expected duration %v, got %v", tt.expected, day.Duration())
+			}
+
+			if day.Start.Hour() != 0 || day.End.Hour() != 0 {
+				t.Errorf("Expected local midnights, got %v - %v", day.Start, day.End)
+			}
+		})
+	}
+}
+
+func TestPeriod_SplitByDaysIn_ShouldCutAtLocalMidnight(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Could not load location: %v", err)
+	}
+
+	// 2024-03-30 12:00 UTC to 2024-04-01 12:00 UTC
+	period, _ := NewPeriod(time.Date(2024, 3, 30, 12, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC))
+
+	var results []Period
+	for d := range period.SplitByDaysIn(paris) {
+		results = append(results, d)
+	}
+
+	expected := []Period{
+		{Start: period.Start, End: DateOnlyIn(2024, 3, 31, paris)},
+		{Start: DateOnlyIn(2024, 3, 31, paris), End: DateOnlyIn(2024, 4, 1, paris)},
+		{Start: DateOnlyIn(2024, 4, 1, paris), End: period.End},
+	}
+
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d periods, got %d", len(expected), len(results))
+	}
+
+	for i, p := range expected {
+		if !results[i].Equal(p) {
+			t.Errorf("Expected period %d to be %v, got %v", i, p, results[i])
+		}
+	}
+
+	if results[1].Duration() != 23*time.Hour {
+		t.Errorf("Expected 23h on DST day, got %v", results[1].Duration())
+	}
+}
+
+func TestPeriod_SplitByMonthsIn_ShouldCutAtLocalMonthStart(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Could not load location: %v", err)
+	}
+
+	year, _ := Year(2024)
+	count := 0
+	var last Period
+	for m := range year.SplitByMonthsIn(paris) {
+		count++
+		last = m
+	}
+
+	// UTC year starts one hour after Paris midnight, so a partial month is added at the end
+	if count != 13 {
+		t.Errorf("Expected 13 periods, got %d", count)
+	}
+
+	if !last.Start.Equal(DateOnlyIn(2025, 1, 1, paris)) || !last.End.Equal(year.End) {
+		t.Errorf("Expected last period to be %v - %v, got %v", DateOnlyIn(2025, 1, 1, paris), year.End, last)
+	}
+}
+
+func TestPeriod_Contains_ShouldExcludeEnd(t *testing.T) {
+	period, _ := Month(2024, 1)
+
+	if !period.Contains(period.Start) {
+		t.Errorf("expected start %v to be contained in the period", period.Start)
+	}
+
+	if period.Contains(period.End) {
+		t.Errorf("expected end %v not to be contained in the period", period.End)
+	}
+}
+
+func TestNewPeriodWithBounds(t *testing.T) {
+	start := DateOnly(2024, 3, 1)
+	end := DateOnly(2024, 3, 31)
+
+	tests := []struct {
+		name          string
+		startBound    Bound
+		endBound      Bound
+		containsStart bool
+		containsEnd   bool
+	}{
+		{name: "closed-open", startBound: Inclusive, endBound: Exclusive, containsStart: true},
+		{name: "closed", startBound: Inclusive, endBound: Inclusive, containsStart: true, containsEnd: true},
+		{name: "open", startBound: Exclusive, endBound: Exclusive},
+		{name: "open-closed", startBound: Exclusive, endBound: Inclusive, containsEnd: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := NewPeriodWithBounds(start, tt.startBound, end, tt.endBound)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !period.Start.Equal(start) || !period.End.Equal(end) {
+				t.Errorf("Expected %v - %v, got %v - %v", start, end, period.Start, period.End)
+			}
+			if period.Duration() != end.Sub(start) {
+				t.Errorf("Expected duration %v, got %v", end.Sub(start), period.Duration())
+			}
+
+			if period.Contains(start) != tt.containsStart {
+				t.Errorf("Expected Contains(start) to be %v", tt.containsStart)
+			}
+			if period.Contains(end) != tt.containsEnd {
+				t.Errorf("Expected Contains(end) to be %v", tt.containsEnd)
+			}
+			if !period.Contains(start.Add(time.Nanosecond)) || !period.Contains(end.Add(-time.Nanosecond)) {
+				t.Errorf("Expected inner instants to be contained")
+			}
+		})
+	}
+}
+
+func TestPeriod_Bounds_ShouldBeHonored(t *testing.T) {
+	closed, _ := NewPeriodWithBounds(DateOnly(2024, 1, 1), Inclusive, DateOnly(2024, 2, 1), Inclusive)
+	february, _ := Month(2024, 2)
+	afterJanuary, _ := NewPeriodWithBounds(DateOnly(2024, 2, 1), Exclusive, DateOnly(2024, 3, 1), Exclusive)
+
+	if !closed.Intersects(*february) || !february.Intersects(*closed) {
+		t.Errorf("Expected %v and %v to share their bound", *closed, *february)
+	}
+	if closed.Intersects(*afterJanuary) || closed.After(*afterJanuary) || !closed.Before(*afterJanuary) {
+		t.Errorf("Expected %v to be before %v", *closed, *afterJanuary)
+	}
+
+	clamp, err := closed.Clamp(*february)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected, _ := NewPeriodWithBounds(DateOnly(2024, 2, 1), Inclusive, DateOnly(2024, 2, 1), Inclusive)
+	if !clamp.Equal(*expected) || clamp.IsEmpty() {
+		t.Errorf("Expected %v, got %v", *expected, clamp)
+	}
+
+	halfOpen, _ := Month(2024, 1)
+	if closed.Equal(*halfOpen) || !closed.ContainsPeriod(*halfOpen) || halfOpen.ContainsPeriod(*closed) {
+		t.Errorf("Expected %v to strictly contain %v", *closed, *halfOpen)
+	}
+	if !closed.LastInstant().Equal(DateOnly(2024, 2, 1)) {
+		t.Errorf("Expected last instant to be the inclusive end, got %v", closed.LastInstant())
+	}
+}
+
+func TestNewPeriodWithBounds_Invalid(t *testing.T) {
+	start := DateOnly(2024, 3, 1)
+
+	if _, err := NewPeriodWithBounds(start, Inclusive, start, Exclusive); err == nil {
+		t.Error("expected an error for an empty half-open period")
+	}
+
+	if _, err := NewPeriodWithBounds(start, Inclusive, start, Inclusive); err != nil {
+		t.Errorf("expected a single instant closed period to be valid, got %v", err)
+	}
+}
+
+func TestNewPeriodFrom_ShouldNeverEnd(t *testing.T) {
+	contract, err := NewPeriodFrom(DateOnly(2024, 3, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !contract.HasInfiniteEnd() || contract.HasInfiniteStart() || contract.IsBounded() {
+		t.Errorf("Expected period to be unbounded in the future only, got %v", contract)
+	}
+
+	if !contract.Contains(DateOnly(9999, 12, 31)) {
+		t.Errorf("Expected far future to be contained")
+	}
+
+	if contract.Contains(DateOnly(2024, 2, 29)) {
+		t.Errorf("Expected date before start not to be contained")
+	}
+
+	if contract.Duration() != time.Duration(math.MaxInt64) {
+		t.Errorf("Expected saturated duration, got %v", contract.Duration())
+	}
+
+	if _, err := NewPeriodFrom(PositiveInfinity); err == nil {
+		t.Error("expected an error when starting at positive infinity")
+	}
+}
+
+func TestPeriod_InfiniteBounds(t *testing.T) {
+	contract, _ := NewPeriodFrom(DateOnly(2024, 3, 1))
+	history, _ := NewPeriodUntil(DateOnly(2024, 3, 15))
+	march, _ := Month(2024, 3)
+
+	forever := Forever()
+
+	if !contract.Intersects(*history) || !forever.Intersects(*march) {
+		t.Errorf("Expected infinite periods to intersect")
+	}
+
+	clamped, err := contract.Clamp(*history)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !clamped.Equal(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 3, 15)}) {
+		t.Errorf("Expected clamp to be bounded, got %v", clamped)
+	}
+
+	var results []Period
+	for p := range contract.SplitFromPeriod(*march) {
+		results = append(results, p)
+	}
+	if len(results) != 2 || !results[0].Equal(*march) || !results[1].Equal(Period{Start: march.End, End: PositiveInfinity}) {
+		t.Errorf("Expected march and an unbounded remainder, got %v", results)
+	}
+
+	if !forever.ContainsPeriod(*contract) || contract.ContainsPeriod(forever) {
+		t.Errorf("Expected forever to contain any period")
+	}
+}
+
+func TestDayIn_ShouldDefaultNilLocationToUTC(t *testing.T) {
+	day, err := DayIn(2024, 3, 31, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected, _ := Day(2024, 3, 31)
+	if !day.Equal(*expected) {
+		t.Errorf("Expected %v, got %v", *expected, *day)
+	}
+
+	january, _ := Month(2024, 1)
+	count := 0
+	for range january.SplitByDaysIn(nil) {
+		count++
+	}
+	if count != 31 {
+		t.Errorf("Expected 31 days, got %d", count)
+	}
+}
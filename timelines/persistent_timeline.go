@@ -0,0 +1,82 @@
+package timelines
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// PersistentTimeline is an immutable timeline: every operation returns a new version sharing most of its
+// structure with the previous one, which remains valid. Versions can be kept for undo and redo,
+// or shared between goroutines without copying nor locking.
+type PersistentTimeline[T any] struct {
+	index intervalTree[T]
+}
+
+// NewPersistentTimeline creates a PersistentTimeline holding the items of given Timeline.
+func NewPersistentTimeline[T any](t Timeline[T]) PersistentTimeline[T] {
+	items := slices.Clone(t.Items)
+	sortStableByPeriodStart(items)
+
+	return PersistentTimeline[T]{index: newIntervalTree(items)}
+}
+
+// Add returns a new version having a new PeriodValue.
+func (p PersistentTimeline[T]) Add(newPeriod Period, newValue T) PersistentTimeline[T] {
+	p.index.Insert(NewPeriodValue(newPeriod, newValue))
+	return p
+}
+
+// Delete returns a new version without any coverage of given period, items straddling its bounds being split.
+func (p PersistentTimeline[T]) Delete(period Period) PersistentTimeline[T] {
+	if period.IsEmpty() {
+		return p
+	}
+
+	for _, n := range p.index.intersectingNodes(period) {
+		p.index.remove(n)
+
+		for part := range n.item.Period.SplitFromPeriodSeq(period) {
+			if !part.End.After(period.Start) || !part.Start.Before(period.End) {
+				p.index.Insert(NewPeriodValue(part, n.item.Value))
+			}
+		}
+	}
+
+	return p
+}
+
+// Set returns a new version where given period only has given value, items overlapping it being carved.
+func (p PersistentTimeline[T]) Set(period Period, value T) PersistentTimeline[T] {
+	if period.IsEmpty() {
+		return p
+	}
+	return p.Delete(period).Add(period, value)
+}
+
+// Len returns the number of items.
+func (p PersistentTimeline[T]) Len() int {
+	return p.index.Len()
+}
+
+// Timeline returns a Timeline holding all items sorted by the Start date of their Periods.
+func (p PersistentTimeline[T]) Timeline() Timeline[T] {
+	return Timeline[T]{Items: p.index.Items()}
+}
+
+// All returns an iterator over all items sorted by the Start date of their Periods.
+func (p PersistentTimeline[T]) All() iter.Seq[PeriodValue[T]] {
+	return func(yield func(PeriodValue[T]) bool) {
+		p.index.root.all(yield)
+	}
+}
+
+// FindIntersects returns items overlapping given period.
+func (p PersistentTimeline[T]) FindIntersects(period Period) []PeriodValue[T] {
+	return p.index.Intersects(period)
+}
+
+// FindAt returns items covering given instant.
+func (p PersistentTimeline[T]) FindAt(instant time.Time) []PeriodValue[T] {
+	return p.index.At(instant)
+}
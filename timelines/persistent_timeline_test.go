@@ -0,0 +1,138 @@
+package timelines
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// checkIntervalNodes verifies AVL invariants and maxEnd of the subtree, returning its height.
+func checkIntervalNodes[T any](t *testing.T, n *intervalNode[T]) int {
+	t.Helper()
+
+	if n == nil {
+		return 0
+	}
+
+	left, right := checkIntervalNodes(t, n.left), checkIntervalNodes(t, n.right)
+	if left-right > 1 || right-left > 1 || n.height != 1+max(left, right) {
+		t.Fatalf("Unbalanced node %v: %d/%d", n.item, left, right)
+	}
+
+	maxEnd := n.item.Period.End
+	if n.left != nil {
+		maxEnd = maxTime(maxEnd, n.left.maxEnd)
+	}
+	if n.right != nil {
+		maxEnd = maxTime(maxEnd, n.right.maxEnd)
+	}
+	if !n.maxEnd.Equal(maxEnd) {
+		t.Fatalf("Wrong maxEnd on node %v", n.item)
+	}
+
+	return n.height
+}
+
+func comparePeriodValues(a, b PeriodValue[int]) int {
+	if c := a.Period.Start.Compare(b.Period.Start); c != 0 {
+		return c
+	}
+	if c := a.Period.End.Compare(b.Period.End); c != 0 {
+		return c
+	}
+	return a.Value - b.Value
+}
+
+func TestPersistentTimeline_ShouldKeepPreviousVersions(t *testing.T) {
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	v0 := NewPersistentTimeline(NewTimeline[int]())
+	v1 := v0.Add(*january, 1)
+	v2 := v1.Add(*february, 2)
+	v3 := v2.Set(Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}, 3)
+	v4 := v3.Delete(Period{Start: DateOnly(2024, 1, 25), End: DateOnly(2024, 2, 5)})
+
+	if v0.Len() != 0 || v1.Len() != 1 {
+		t.Errorf("Expected previous versions to be unchanged, got %d and %d items", v0.Len(), v1.Len())
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{NewPeriodValue(*january, 1), NewPeriodValue(*february, 2)}, v2.Timeline().Items)
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}, Value: 3},
+		{Period: Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 3, 1)}, Value: 2},
+	}, v3.Timeline().Items)
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 25)}, Value: 3},
+		{Period: Period{Start: DateOnly(2024, 2, 5), End: DateOnly(2024, 2, 10)}, Value: 3},
+		{Period: Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 3, 1)}, Value: 2},
+	}, v4.Timeline().Items)
+
+	if values := v4.FindAt(DateOnly(2024, 2, 1)); len(values) != 0 {
+		t.Errorf("Expected no value on deleted period, got %v", values)
+	}
+}
+
+func TestPersistentTimeline_ShouldMatchNaiveModel(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	origin := DateOnly(2024, 1, 1)
+	randomPeriod := func() Period {
+		start := origin.Add(time.Duration(r.Intn(60)) * 24 * time.Hour)
+		return Period{Start: start, End: start.Add(time.Duration(1+r.Intn(10)) * 24 * time.Hour)}
+	}
+
+	var versions []PersistentTimeline[int]
+	var models [][]PeriodValue[int]
+
+	current := NewPersistentTimeline(NewTimeline[int]())
+	var model []PeriodValue[int]
+
+	for i := 0; i < 300; i++ {
+		period := randomPeriod()
+		if r.Intn(3) == 0 {
+			current = current.Delete(period)
+
+			var next []PeriodValue[int]
+			for _, pv := range model {
+				if !pv.Period.Intersects(period) {
+					next = append(next, pv)
+					continue
+				}
+				for part := range pv.Period.SplitFromPeriodSeq(period) {
+					if !part.Intersects(period) {
+						next = append(next, NewPeriodValue(part, pv.Value))
+					}
+				}
+			}
+			model = next
+		} else {
+			current = current.Add(period, i)
+			model = append(slices.Clone(model), NewPeriodValue(period, i))
+		}
+
+		checkIntervalNodes(t, current.index.root)
+		versions = append(versions, current)
+		models = append(models, model)
+	}
+
+	// every version still matches the model at that time
+	for i, version := range versions {
+		var items []PeriodValue[int]
+		for pv := range version.All() {
+			items = append(items, pv)
+		}
+		if !slices.IsSortedFunc(items, func(a, b PeriodValue[int]) int { return a.Period.Start.Compare(b.Period.Start) }) {
+			t.Fatalf("Expected version %d to be sorted", i)
+		}
+
+		expected := slices.Clone(models[i])
+		slices.SortFunc(items, comparePeriodValues)
+		slices.SortFunc(expected, comparePeriodValues)
+		assertPeriodValues(t, expected, items)
+	}
+}
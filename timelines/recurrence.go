@@ -0,0 +1,358 @@
+package timelines
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the base interval on which a Recurrence repeats.
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// WeekdayOccurrence selects a weekday. When N is not zero, only the N-th occurrence of that weekday
+// within the month (or the year for yearly recurrences without ByMonth) is selected, negative values counting from the end.
+type WeekdayOccurrence struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Recurrence is a schedule of periods following iCalendar RRULE semantics (RFC 5545).
+// Weeks start on Monday.
+type Recurrence struct {
+	// Start is the first occurrence; it gives the time of day and location of all occurrences.
+	Start time.Time
+	// Duration is the length of each occurrence.
+	Duration  time.Duration
+	Frequency Frequency
+	// Interval repeats every Interval frequency periods, 0 meaning 1.
+	Interval int
+	// Count limits the number of occurrences since Start, 0 meaning unlimited.
+	Count int
+	// Until is the last instant an occurrence may start at, zero meaning unlimited.
+	Until      time.Time
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []WeekdayOccurrence
+	BySetPos   []int
+}
+
+var frequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRecurrence parses an iCalendar RRULE such as "FREQ=MONTHLY;BYDAY=2TU" into a Recurrence
+// whose occurrences last given duration, the first one starting at start.
+// Supported parts are FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY and BYSETPOS.
+func ParseRecurrence(rule string, start time.Time, duration time.Duration) (*Recurrence, error) {
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+
+	r := &Recurrence{Start: start, Duration: duration, Frequency: -1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		name, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+		switch name {
+		case "FREQ":
+			frequency, ok := frequencies[value]
+			if !ok {
+				return nil, fmt.Errorf("unsupported frequency %q", value)
+			}
+			r.Frequency = frequency
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = errors.New("must be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err == nil && r.Count < 1 {
+				err = errors.New("must be positive")
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value, start.Location())
+		case "BYMONTH":
+			err = parseList(value, func(n int) error {
+				if n < 1 || n > 12 {
+					return errors.New("month out of range")
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(n))
+				return nil
+			})
+		case "BYMONTHDAY":
+			err = parseList(value, func(n int) error {
+				if n == 0 || n < -31 || n > 31 {
+					return errors.New("day out of range")
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+				return nil
+			})
+		case "BYSETPOS":
+			err = parseList(value, func(n int) error {
+				if n == 0 {
+					return errors.New("position out of range")
+				}
+				r.BySetPos = append(r.BySetPos, n)
+				return nil
+			})
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				if len(day) < 2 {
+					return nil, fmt.Errorf("invalid BYDAY %q", day)
+				}
+				weekday, ok := weekdays[day[len(day)-2:]]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY %q", day)
+				}
+				occurrence := WeekdayOccurrence{Weekday: weekday}
+				if prefix := day[:len(day)-2]; prefix != "" {
+					occurrence.N, err = strconv.Atoi(prefix)
+					if err != nil || occurrence.N == 0 {
+						return nil, fmt.Errorf("invalid BYDAY %q", day)
+					}
+				}
+				r.ByDay = append(r.ByDay, occurrence)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", name)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+
+	if r.Frequency < 0 {
+		return nil, errors.New("rule must have a FREQ")
+	}
+
+	for _, day := range r.ByDay {
+		if day.N != 0 && r.Frequency != Monthly && r.Frequency != Yearly {
+			return nil, errors.New("numbered BYDAY is only allowed with MONTHLY or YEARLY frequency")
+		}
+	}
+
+	return r, nil
+}
+
+func parseList(value string, f func(n int) error) error {
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return err
+		}
+		if err := f(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// a date includes the whole day
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
+
+// Periods returns occurrences starting within given period, in chronological order.
+// Either the period end must be finite, or the recurrence must have an Until or a Count.
+func (r *Recurrence) Periods(within Period) ([]Period, error) {
+	if r.Until.IsZero() && r.Count <= 0 && within.HasInfiniteEnd() {
+		return nil, errors.New("recurrence needs a bounded period, an until date or a count")
+	}
+
+	limit := within.End
+	if !r.Until.IsZero() {
+		limit = minTime(limit, r.Until.Add(time.Nanosecond))
+	}
+
+	interval := max(r.Interval, 1)
+	hour, minute, second := r.Start.Clock()
+	first := r.frequencyStart()
+
+	var periods []Period
+	count := 0
+
+	for k := 0; ; k++ {
+		frequencyStart := r.addFrequency(first, k*interval)
+		if !time.Date(frequencyStart.Year(), frequencyStart.Month(), frequencyStart.Day(), 0, 0, 0, 0, r.Start.Location()).Before(limit) {
+			return periods, nil
+		}
+
+		for _, day := range r.candidates(frequencyStart, r.addFrequency(first, k*interval+1)) {
+			start := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, r.Start.Nanosecond(), r.Start.Location())
+			if start.Before(r.Start) {
+				continue
+			}
+			if !start.Before(limit) {
+				return periods, nil
+			}
+
+			if !start.Before(within.Start) {
+				periods = append(periods, Period{Start: start, End: start.Add(r.Duration)})
+			}
+
+			count++
+			if r.Count > 0 && count == r.Count {
+				return periods, nil
+			}
+		}
+	}
+}
+
+// frequencyStart returns the civil date, as UTC midnight, starting the frequency period containing Start.
+func (r *Recurrence) frequencyStart() time.Time {
+	year, month, day := r.Start.Date()
+
+	switch r.Frequency {
+	case Weekly:
+		offset := (int(r.Start.Weekday()) + 6) % 7 // days since Monday
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, time.UTC)
+	case Monthly:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	case Yearly:
+		return time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func (r *Recurrence) addFrequency(t time.Time, n int) time.Time {
+	switch r.Frequency {
+	case Weekly:
+		return t.AddDate(0, 0, 7*n)
+	case Monthly:
+		return t.AddDate(0, n, 0)
+	case Yearly:
+		return t.AddDate(n, 0, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
+
+// candidates returns the civil dates of [from, to) selected by the rule, BySetPos applied.
+func (r *Recurrence) candidates(from time.Time, to time.Time) []time.Time {
+	var days []time.Time
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if r.matches(day) {
+			days = append(days, day)
+		}
+	}
+
+	if len(r.BySetPos) == 0 {
+		return days
+	}
+
+	var selected []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(days) + pos
+		}
+		if i >= 0 && i < len(days) && !slices.Contains(selected, days[i]) {
+			selected = append(selected, days[i])
+		}
+	}
+	slices.SortFunc(selected, time.Time.Compare)
+
+	return selected
+}
+
+func (r *Recurrence) matches(day time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, day.Month()) {
+		return false
+	}
+
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		// without day rules, occurrences fall on the same day as Start within each frequency period
+		switch r.Frequency {
+		case Weekly:
+			return day.Weekday() == r.Start.Weekday()
+		case Monthly:
+			return day.Day() == r.Start.Day()
+		case Yearly:
+			return day.Day() == r.Start.Day() && (len(r.ByMonth) > 0 || day.Month() == r.Start.Month())
+		}
+		return true
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if !slices.Contains(r.ByMonthDay, day.Day()) && !slices.Contains(r.ByMonthDay, day.Day()-daysInMonth-1) {
+			return false
+		}
+	}
+
+	if len(r.ByDay) > 0 {
+		return slices.ContainsFunc(r.ByDay, func(occurrence WeekdayOccurrence) bool {
+			return occurrence.Weekday == day.Weekday() && (occurrence.N == 0 || r.isNthWeekday(day, occurrence.N))
+		})
+	}
+
+	return true
+}
+
+// isNthWeekday checks if day is the n-th occurrence of its weekday within its month,
+// or within its year for yearly recurrences without ByMonth.
+func (r *Recurrence) isNthWeekday(day time.Time, n int) bool {
+	index, total := day.Day(), time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if r.Frequency == Yearly && len(r.ByMonth) == 0 {
+		index, total = day.YearDay(), time.Date(day.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+
+	if n > 0 {
+		return (index-1)/7+1 == n
+	}
+	return -((total-index)/7 + 1) == n
+}
+
+// RecurringPeriodValues returns a PeriodValue for each occurrence of the recurrence starting within given period.
+func RecurringPeriodValues[T any](r *Recurrence, within Period, value func(period Period) T) ([]PeriodValue[T], error) {
+	periods, err := r.Periods(within)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]PeriodValue[T], 0, len(periods))
+	for _, period := range periods {
+		items = append(items, NewPeriodValue(period, value(period)))
+	}
+	return items, nil
+}
@@ -0,0 +1,205 @@
+package timelines
+
+import (
+	"testing"
+	"time"
+)
+
+func recurrenceStarts(t *testing.T, rule string, start time.Time, within Period) []time.Time {
+	t.Helper()
+
+	r, err := ParseRecurrence(rule, start, time.Hour)
+	if err != nil {
+		t.Fatalf("Could not parse %q: %v", rule, err)
+	}
+
+	periods, err := r.Periods(within)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var starts []time.Time
+	for _, p := range periods {
+		if p.Duration() != time.Hour {
+			t.Errorf("Expected occurrence to last 1h, got %v", p.Duration())
+		}
+		starts = append(starts, p.Start)
+	}
+	return starts
+}
+
+func TestRecurrence_Periods(t *testing.T) {
+	year2024, _ := Year(2024)
+	year := *year2024
+	q1 := Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 4, 1)}
+	at9 := func(year int, month int, day int) time.Time {
+		return time.Date(year, time.Month(month), day, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		start    time.Time
+		within   Period
+		expected []time.Time
+	}{
+		{
+			name:     "every second tuesday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU",
+			start:    at9(2024, 1, 2),
+			within:   Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 15)},
+			expected: []time.Time{at9(2024, 1, 2), at9(2024, 1, 16), at9(2024, 1, 30), at9(2024, 2, 13)},
+		},
+		{
+			name:     "second tuesday of each month",
+			rule:     "RRULE:FREQ=MONTHLY;BYDAY=2TU",
+			start:    at9(2024, 1, 1),
+			within:   q1,
+			expected: []time.Time{at9(2024, 1, 9), at9(2024, 2, 13), at9(2024, 3, 12)},
+		},
+		{
+			name:     "last business day of each month",
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			start:    at9(2024, 1, 1),
+			within:   q1,
+			expected: []time.Time{at9(2024, 1, 31), at9(2024, 2, 29), at9(2024, 3, 29)},
+		},
+		{
+			name:     "last day of month",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start:    at9(2024, 1, 1),
+			within:   q1,
+			expected: []time.Time{at9(2024, 1, 31), at9(2024, 2, 29), at9(2024, 3, 31)},
+		},
+		{
+			name:     "monthly on start day skips short months",
+			rule:     "FREQ=MONTHLY",
+			start:    at9(2024, 1, 31),
+			within:   year,
+			expected: []time.Time{at9(2024, 1, 31), at9(2024, 3, 31), at9(2024, 5, 31), at9(2024, 7, 31), at9(2024, 8, 31), at9(2024, 10, 31), at9(2024, 12, 31)},
+		},
+		{
+			name:     "daily with count",
+			rule:     "FREQ=DAILY;COUNT=3",
+			start:    at9(2023, 12, 31),
+			within:   year,
+			expected: []time.Time{at9(2024, 1, 1), at9(2024, 1, 2)},
+		},
+		{
+			name:     "weekly until",
+			rule:     "FREQ=WEEKLY;UNTIL=20240115",
+			start:    at9(2024, 1, 1),
+			within:   year,
+			expected: []time.Time{at9(2024, 1, 1), at9(2024, 1, 8), at9(2024, 1, 15)},
+		},
+		{
+			name:     "yearly last friday of november",
+			rule:     "FREQ=YEARLY;BYMONTH=11;BYDAY=-1FR",
+			start:    at9(2024, 1, 1),
+			within:   Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2026, 1, 1)},
+			expected: []time.Time{at9(2024, 11, 29), at9(2025, 11, 28)},
+		},
+		{
+			name:     "yearly on start day",
+			rule:     "FREQ=YEARLY",
+			start:    at9(2024, 2, 29),
+			within:   Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2029, 1, 1)},
+			expected: []time.Time{at9(2024, 2, 29), at9(2028, 2, 29)},
+		},
+		{
+			name:     "count with unbounded period",
+			rule:     "FREQ=DAILY;COUNT=3",
+			start:    at9(2024, 1, 1),
+			within:   Period{Start: DateOnly(2024, 1, 1), End: PositiveInfinity},
+			expected: []time.Time{at9(2024, 1, 1), at9(2024, 1, 2), at9(2024, 1, 3)},
+		},
+		{
+			name:     "yearly 20th monday",
+			rule:     "FREQ=YEARLY;BYDAY=20MO",
+			start:    at9(2024, 1, 1),
+			within:   year,
+			expected: []time.Time{at9(2024, 5, 13)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			starts := recurrenceStarts(t, tt.rule, tt.start, tt.within)
+
+			if len(starts) != len(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, starts)
+			}
+
+			for i, e := range tt.expected {
+				if !starts[i].Equal(e) {
+					t.Errorf("Expected occurrence %d at %v, got %v", i, e, starts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrence_ShouldKeepWallClockAcrossDaylightSavingTime(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Could not load location: %v", err)
+	}
+
+	start := time.Date(2024, 3, 29, 9, 0, 0, 0, paris)
+	within := Period{Start: start, End: time.Date(2024, 4, 2, 0, 0, 0, 0, paris)}
+
+	for _, s := range recurrenceStarts(t, "FREQ=DAILY", start, within) {
+		if s.In(paris).Hour() != 9 {
+			t.Errorf("Expected occurrence at 09:00 local time, got %v", s.In(paris))
+		}
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=WEEKLY;BYDAY=2TU",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;WKST=SU",
+	} {
+		if _, err := ParseRecurrence(rule, DateOnly(2024, 1, 1), time.Hour); err == nil {
+			t.Errorf("Expected an error for %q", rule)
+		}
+	}
+
+	r, _ := ParseRecurrence("FREQ=DAILY", DateOnly(2024, 1, 1), time.Hour)
+	contract, _ := NewPeriodFrom(DateOnly(2024, 1, 1))
+	if _, err := r.Periods(*contract); err == nil {
+		t.Errorf("Expected an error for an unbounded recurrence")
+	}
+}
+
+func TestTimeLineBuilder_AddRecurrence(t *testing.T) {
+	r, err := ParseRecurrence("FREQ=WEEKLY;BYDAY=MO,FR", time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), 8*time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	january, _ := Month(2024, 1)
+	timeline, err := NewTimeLineBuilder[string]().
+		AddRecurrence(r, *january, "office").
+		AddMonth(2024, 1, "contract").
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	// 5 mondays and 4 fridays in january 2024
+	if len(timeline.Items) != 10 {
+		t.Fatalf("Expected 10 items, got %d", len(timeline.Items))
+	}
+
+	first := timeline.Items[1]
+	if first.Value != "office" || !first.Period.Equal(Period{Start: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC)}) {
+		t.Errorf("Unexpected first occurrence %v", first)
+	}
+}
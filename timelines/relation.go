@@ -0,0 +1,158 @@
+package timelines
+
+// Relation is one of the 13 relations of Allen's interval algebra.
+type Relation int
+
+const (
+	// RelationBefore means the period ends strictly before the other one starts.
+	RelationBefore Relation = iota
+	// RelationMeets means the period ends exactly when the other one starts.
+	RelationMeets
+	// RelationOverlaps means the period starts first and ends while the other one is running.
+	RelationOverlaps
+	// RelationStarts means both periods start together and the period ends first.
+	RelationStarts
+	// RelationDuring means the period is strictly inside the other one.
+	RelationDuring
+	// RelationFinishes means both periods end together and the period starts last.
+	RelationFinishes
+	// RelationEquals means both periods have same start and end.
+	RelationEquals
+	// RelationFinishedBy is the inverse of RelationFinishes.
+	RelationFinishedBy
+	// RelationContains is the inverse of RelationDuring.
+	RelationContains
+	// RelationStartedBy is the inverse of RelationStarts.
+	RelationStartedBy
+	// RelationOverlappedBy is the inverse of RelationOverlaps.
+	RelationOverlappedBy
+	// RelationMetBy is the inverse of RelationMeets.
+	RelationMetBy
+	// RelationAfter is the inverse of RelationBefore.
+	RelationAfter
+)
+
+var relationNames = [...]string{
+	RelationBefore:       "before",
+	RelationMeets:        "meets",
+	RelationOverlaps:     "overlaps",
+	RelationStarts:       "starts",
+	RelationDuring:       "during",
+	RelationFinishes:     "finishes",
+	RelationEquals:       "equals",
+	RelationFinishedBy:   "finished by",
+	RelationContains:     "contains",
+	RelationStartedBy:    "started by",
+	RelationOverlappedBy: "overlapped by",
+	RelationMetBy:        "met by",
+	RelationAfter:        "after",
+}
+
+// String returns the name of the relation.
+func (r Relation) String() string {
+	if r < RelationBefore || r > RelationAfter {
+		return "unknown"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation seen from the other period.
+func (r Relation) Inverse() Relation {
+	return RelationAfter - r
+}
+
+// Relation returns how the period is related to the other one.
+// Both periods are considered as [Start, End) and must not be empty.
+func (p *Period) Relation(other Period) Relation {
+	startVsStart := p.Start.Compare(other.Start)
+	endVsEnd := p.End.Compare(other.End)
+
+	switch {
+	case p.End.Before(other.Start):
+		return RelationBefore
+	case p.End.Equal(other.Start):
+		return RelationMeets
+	case p.Start.After(other.End):
+		return RelationAfter
+	case p.Start.Equal(other.End):
+		return RelationMetBy
+	case startVsStart == 0 && endVsEnd == 0:
+		return RelationEquals
+	case startVsStart == 0 && endVsEnd < 0:
+		return RelationStarts
+	case startVsStart == 0:
+		return RelationStartedBy
+	case endVsEnd == 0 && startVsStart > 0:
+		return RelationFinishes
+	case endVsEnd == 0:
+		return RelationFinishedBy
+	case startVsStart > 0 && endVsEnd < 0:
+		return RelationDuring
+	case startVsStart < 0 && endVsEnd > 0:
+		return RelationContains
+	case startVsStart < 0:
+		return RelationOverlaps
+	default:
+		return RelationOverlappedBy
+	}
+}
+
+// Precedes checks if the period ends strictly before the other one starts, leaving a gap between them.
+func (p *Period) Precedes(other Period) bool {
+	return p.Relation(other) == RelationBefore
+}
+
+// Follows checks if the period starts strictly after the other one ends, leaving a gap between them.
+func (p *Period) Follows(other Period) bool {
+	return p.Relation(other) == RelationAfter
+}
+
+// Meets checks if the period ends exactly when the other one starts.
+func (p *Period) Meets(other Period) bool {
+	return p.Relation(other) == RelationMeets
+}
+
+// MetBy checks if the period starts exactly when the other one ends.
+func (p *Period) MetBy(other Period) bool {
+	return p.Relation(other) == RelationMetBy
+}
+
+// Overlaps checks if the period starts first and ends while the other one is running.
+func (p *Period) Overlaps(other Period) bool {
+	return p.Relation(other) == RelationOverlaps
+}
+
+// OverlappedBy checks if the other period starts first and ends while the period is running.
+func (p *Period) OverlappedBy(other Period) bool {
+	return p.Relation(other) == RelationOverlappedBy
+}
+
+// Starts checks if both periods start together and the period ends first.
+func (p *Period) Starts(other Period) bool {
+	return p.Relation(other) == RelationStarts
+}
+
+// StartedBy checks if both periods start together and the other one ends first.
+func (p *Period) StartedBy(other Period) bool {
+	return p.Relation(other) == RelationStartedBy
+}
+
+// During checks if the period is strictly inside the other one.
+func (p *Period) During(other Period) bool {
+	return p.Relation(other) == RelationDuring
+}
+
+// Encloses checks if the other period is strictly inside the period.
+func (p *Period) Encloses(other Period) bool {
+	return p.Relation(other) == RelationContains
+}
+
+// Finishes checks if both periods end together and the period starts last.
+func (p *Period) Finishes(other Period) bool {
+	return p.Relation(other) == RelationFinishes
+}
+
+// FinishedBy checks if both periods end together and the other one starts last.
+func (p *Period) FinishedBy(other Period) bool {
+	return p.Relation(other) == RelationFinishedBy
+}
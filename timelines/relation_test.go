@@ -0,0 +1,73 @@
+package timelines
+
+import (
+	"testing"
+)
+
+func TestPeriod_Relation(t *testing.T) {
+	reference := Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}
+
+	tests := []struct {
+		name     string
+		p        Period
+		expected Relation
+	}{
+		{name: "before", p: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 5)}, expected: RelationBefore},
+		{name: "meets", p: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, expected: RelationMeets},
+		{name: "overlaps", p: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 15)}, expected: RelationOverlaps},
+		{name: "starts", p: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 15)}, expected: RelationStarts},
+		{name: "during", p: Period{Start: DateOnly(2024, 1, 12), End: DateOnly(2024, 1, 15)}, expected: RelationDuring},
+		{name: "finishes", p: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 1, 20)}, expected: RelationFinishes},
+		{name: "equals", p: reference, expected: RelationEquals},
+		{name: "finished by", p: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 20)}, expected: RelationFinishedBy},
+		{name: "contains", p: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 25)}, expected: RelationContains},
+		{name: "started by", p: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 25)}, expected: RelationStartedBy},
+		{name: "overlapped by", p: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 1, 25)}, expected: RelationOverlappedBy},
+		{name: "met by", p: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 25)}, expected: RelationMetBy},
+		{name: "after", p: Period{Start: DateOnly(2024, 1, 22), End: DateOnly(2024, 1, 25)}, expected: RelationAfter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relation := tt.p.Relation(reference)
+			if relation != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, relation)
+			}
+
+			inverse := reference.Relation(tt.p)
+			if inverse != tt.expected.Inverse() {
+				t.Errorf("Expected inverse %v, got %v", tt.expected.Inverse(), inverse)
+			}
+
+			if relation.String() != tt.name {
+				t.Errorf("Expected name %q, got %q", tt.name, relation.String())
+			}
+		})
+	}
+}
+
+func TestPeriod_RelationPredicates_ShouldBeConsistentWithIntersects(t *testing.T) {
+	reference := Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}
+	meeting := Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 25)}
+
+	if !reference.Meets(meeting) || !meeting.MetBy(reference) {
+		t.Errorf("Expected %v to meet %v", reference, meeting)
+	}
+
+	if reference.Intersects(meeting) {
+		t.Errorf("Expected meeting periods not to intersect")
+	}
+
+	if !reference.Before(meeting) || reference.Precedes(meeting) {
+		t.Errorf("Expected meeting period to be before but not to precede")
+	}
+
+	if reference.Contains(meeting.Start) {
+		t.Errorf("Expected end %v to be excluded", meeting.Start)
+	}
+
+	inner := Period{Start: DateOnly(2024, 1, 12), End: DateOnly(2024, 1, 15)}
+	if !inner.During(reference) || !reference.Encloses(inner) || !reference.ContainsPeriod(inner) {
+		t.Errorf("Expected %v to be during %v", inner, reference)
+	}
+}
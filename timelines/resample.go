@@ -0,0 +1,121 @@
+package timelines
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Number is the set of numeric types built-in reducers compute with.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Reducer computes the value of a bucket from the items intersecting it.
+// Items are given whole, ordered by period start: use the bucket to compute overlaps.
+type Reducer[T any] func(bucket Period, items []PeriodValue[T]) T
+
+// Resample returns another Timeline having one item per bucket of given period cut by step,
+// whose value is computed by reduce from items intersecting the bucket. Buckets without items are skipped.
+// Timeline items must be sorted. ErrStepNotAdvancing is returned if step does not return an instant after current one.
+func (t *Timeline[T]) Resample(step func(current time.Time) time.Time, within Period, reduce Reducer[T]) (Timeline[T], error) {
+	result := NewTimeline[T]()
+	var active []PeriodValue[T]
+	next := 0
+
+	for bucket, err := range within.SplitSeq(step) {
+		if err != nil {
+			return NewTimeline[T](), err
+		}
+		bucket.End = minTime(bucket.End, within.End)
+
+		for next < len(t.Items) && t.Items[next].Period.Start.Before(bucket.End) {
+			active = append(active, t.Items[next])
+			next++
+		}
+
+		active = slices.DeleteFunc(active, func(pv PeriodValue[T]) bool {
+			return !pv.Period.End.After(bucket.Start)
+		})
+
+		if len(active) == 0 {
+			continue
+		}
+
+		result.Items = append(result.Items, NewPeriodValue(bucket, reduce(bucket, active)))
+	}
+
+	return result, nil
+}
+
+// overlap returns the duration of the part of period within bucket.
+func overlap(period Period, bucket Period) time.Duration {
+	clamp, err := period.Clamp(bucket)
+	if err != nil {
+		return 0
+	}
+	return clamp.Duration()
+}
+
+// Sum returns a Reducer adding values of all items intersecting the bucket.
+func Sum[N Number]() Reducer[N] {
+	return func(bucket Period, items []PeriodValue[N]) N {
+		var total N
+		for _, pv := range items {
+			total += pv.Value
+		}
+		return total
+	}
+}
+
+// ProratedSum returns a Reducer adding values of items weighted by the part of their period within the bucket,
+// for values being totals over their whole period.
+func ProratedSum[N Number]() Reducer[N] {
+	return func(bucket Period, items []PeriodValue[N]) N {
+		var total float64
+		for _, pv := range items {
+			total += float64(pv.Value) * float64(overlap(pv.Period, bucket)) / float64(pv.Period.Duration())
+		}
+		return N(total)
+	}
+}
+
+// TimeWeightedAverage returns a Reducer averaging values of items weighted by the duration they cover within the bucket.
+// It returns zero when items cover no duration within the bucket.
+func TimeWeightedAverage[N Number]() Reducer[N] {
+	return func(bucket Period, items []PeriodValue[N]) N {
+		var total, weights float64
+		for _, pv := range items {
+			weight := float64(overlap(pv.Period, bucket))
+			total += float64(pv.Value) * weight
+			weights += weight
+		}
+		if weights == 0 {
+			return 0
+		}
+		return N(total / weights)
+	}
+}
+
+// Min returns a Reducer keeping the lowest value of items intersecting the bucket.
+func Min[T cmp.Ordered]() Reducer[T] {
+	return func(bucket Period, items []PeriodValue[T]) T {
+		return slices.MinFunc(items, func(a, b PeriodValue[T]) int { return cmp.Compare(a.Value, b.Value) }).Value
+	}
+}
+
+// Max returns a Reducer keeping the highest value of items intersecting the bucket.
+func Max[T cmp.Ordered]() Reducer[T] {
+	return func(bucket Period, items []PeriodValue[T]) T {
+		return slices.MaxFunc(items, func(a, b PeriodValue[T]) int { return cmp.Compare(a.Value, b.Value) }).Value
+	}
+}
+
+// Last returns a Reducer keeping the value of the last starting item intersecting the bucket.
+func Last[T any]() Reducer[T] {
+	return func(bucket Period, items []PeriodValue[T]) T {
+		return items[len(items)-1].Value
+	}
+}
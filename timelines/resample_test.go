@@ -0,0 +1,137 @@
+package timelines
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func dailyRates(t *testing.T) Timeline[float64] {
+	t.Helper()
+
+	// 10 per day in january, 20 per day from february 1st to 14th
+	builder := NewTimeLineBuilder[float64]()
+	for d := range (&Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 15)}).SplitByDaysSeq() {
+		value := 10.0
+		if d.Start.Month() == time.February {
+			value = 20
+		}
+		builder.AddPeriodValue(NewPeriodValue(d, value))
+	}
+
+	timeline, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+	return timeline
+}
+
+func TestTimeline_Resample_Sum(t *testing.T) {
+	rates := dailyRates(t)
+	q1 := Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 4, 1)}
+
+	monthly, err := rates.Resample(nextMonth, q1, Sum[float64]())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[float64]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, Value: 310},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, Value: 280},
+	}, monthly.Items)
+}
+
+func TestTimeline_Resample_ShouldClampLastBucket(t *testing.T) {
+	rates := dailyRates(t)
+	within := Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}
+
+	monthly, err := rates.Resample(nextMonth, within, Sum[float64]())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[float64]{
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 10)}, Value: 12*10 + 9*20},
+	}, monthly.Items)
+}
+
+func TestTimeline_Resample_Reducers(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[float64]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 11), 100).
+		AddPeriod(DateOnly(2024, 1, 21), DateOnly(2024, 2, 10), 40).
+		Build()
+
+	january, _ := Month(2024, 1)
+
+	tests := []struct {
+		name     string
+		reducer  Reducer[float64]
+		expected float64
+	}{
+		{name: "sum", reducer: Sum[float64](), expected: 140},
+		{name: "prorated sum", reducer: ProratedSum[float64](), expected: 100 + 40*11.0/20},
+		{name: "time weighted average", reducer: TimeWeightedAverage[float64](), expected: (100*10 + 40*11) / 21.0},
+		{name: "min", reducer: Min[float64](), expected: 40},
+		{name: "max", reducer: Max[float64](), expected: 100},
+		{name: "last", reducer: Last[float64](), expected: 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := timeline.Resample(nextMonth, *january, tt.reducer)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.Items) != 1 {
+				t.Fatalf("Expected 1 item, got %d", len(result.Items))
+			}
+
+			if math.Abs(result.Items[0].Value-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, result.Items[0].Value)
+			}
+		})
+	}
+}
+
+func TestTimeWeightedAverage_ShouldReturnZeroWithoutWeight(t *testing.T) {
+	january, _ := Month(2024, 1)
+	items := []PeriodValue[int]{NewPeriodValue(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, 5)}
+
+	if result := TimeWeightedAverage[int]()(*january, items); result != 0 {
+		t.Errorf("Expected 0, got %d", result)
+	}
+	if result := TimeWeightedAverage[float64]()(*january, nil); result != 0 {
+		t.Errorf("Expected 0, got %v", result)
+	}
+}
+
+func TestTimeline_Resample_ShouldSkipEmptyBuckets(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddDay(2024, 1, 2, 5).
+		AddDay(2024, 1, 4, 7).
+		Build()
+
+	week := Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 8)}
+	daily, err := timeline.Resample(nextDay, week, Sum[int]())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 2), End: DateOnly(2024, 1, 3)}, Value: 5},
+		{Period: Period{Start: DateOnly(2024, 1, 4), End: DateOnly(2024, 1, 5)}, Value: 7},
+	}, daily.Items)
+}
+
+func TestTimeline_Resample_ShouldReportStepNotAdvancing(t *testing.T) {
+	rates := dailyRates(t)
+	january, _ := Month(2024, 1)
+
+	_, err := rates.Resample(func(current time.Time) time.Time { return current }, *january, Sum[float64]())
+
+	if !errors.Is(err, ErrStepNotAdvancing) {
+		t.Errorf("Expected ErrStepNotAdvancing, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package timelines
+
+// sidedValue is an aggregated value remembering which timelines contributed to it.
+type sidedValue[T any] struct {
+	value T
+	left  bool
+	right bool
+}
+
+func toSided[T any](items []PeriodValue[T], left bool) []PeriodValue[sidedValue[T]] {
+	sided := make([]PeriodValue[sidedValue[T]], 0, len(items))
+	for _, pv := range items {
+		sided = append(sided, NewPeriodValue(pv.Period, sidedValue[T]{value: pv.Value, left: left, right: !left}))
+	}
+	return sided
+}
+
+// aggregateSides aggregates items of two timelines like Aggregate does, keeping for each sliced period
+// whether left and right items were covering it.
+func aggregateSides[T any](left []PeriodValue[T], right []PeriodValue[T], f func(period Period, a T, b T) T) ([]PeriodValue[sidedValue[T]], error) {
+	concat := append(toSided(left, true), toSided(right, false)...)
+	sortByPeriodStart(concat)
+
+	return resolveConflicts(concat, func(period Period, a sidedValue[T], b sidedValue[T]) sidedValue[T] {
+		return sidedValue[T]{
+			value: f(period, a.value, b.value),
+			left:  a.left || b.left,
+			right: a.right || b.right,
+		}
+	})
+}
+
+func keepSides[T any](sided []PeriodValue[sidedValue[T]], keep func(v sidedValue[T]) bool) Timeline[T] {
+	items := []PeriodValue[T]{}
+	for _, pv := range sided {
+		if keep(pv.Value) {
+			items = append(items, NewPeriodValue(pv.Period, pv.Value.value))
+		}
+	}
+	return Timeline[T]{Items: items}
+}
+
+// Intersect returns a timeline covering only periods covered by both timelines.
+// Values are aggregated the same way Aggregate does, slicing periods if necessary.
+func (t *Timeline[T]) Intersect(other *Timeline[T], f func(period Period, a T, b T) T) (Timeline[T], error) {
+	sided, err := aggregateSides(t.Items, other.Items, f)
+	if err != nil {
+		return Timeline[T]{}, err
+	}
+
+	return keepSides(sided, func(v sidedValue[T]) bool { return v.left && v.right }), nil
+}
+
+// Subtract returns a timeline covering periods of current timeline not covered by the other one.
+// Overlapping values of current timeline are aggregated with f, slicing periods if necessary.
+func (t *Timeline[T]) Subtract(other *Timeline[T], f func(period Period, a T, b T) T) (Timeline[T], error) {
+	sided, err := aggregateSides(t.Items, other.Items, f)
+	if err != nil {
+		return Timeline[T]{}, err
+	}
+
+	return keepSides(sided, func(v sidedValue[T]) bool { return v.left && !v.right }), nil
+}
+
+// SubtractPeriods returns a timeline covering periods of current timeline outside of given periods.
+// Overlapping values of current timeline are aggregated with f, slicing periods if necessary.
+func (t *Timeline[T]) SubtractPeriods(periods []Period, f func(period Period, a T, b T) T) (Timeline[T], error) {
+	other := Timeline[T]{Items: make([]PeriodValue[T], 0, len(periods))}
+	for _, period := range periods {
+		var zero T
+		other.Items = append(other.Items, NewPeriodValue(period, zero))
+	}
+
+	return t.Subtract(&other, f)
+}
+
+// Complement returns a timeline covering periods within limit not covered by current timeline,
+// using f to compute the value of each of them. Timeline items must be sorted.
+func (t *Timeline[T]) Complement(within Period, f func(period Period) T) Timeline[T] {
+	items := []PeriodValue[T]{}
+	for _, gap := range t.Gaps(within) {
+		items = append(items, NewPeriodValue(gap, f(gap)))
+	}
+	return Timeline[T]{Items: items}
+}
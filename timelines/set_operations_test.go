@@ -0,0 +1,107 @@
+package timelines
+
+import (
+	"testing"
+)
+
+func sum(p Period, a int, b int) int {
+	return a + b
+}
+
+func assertPeriodValues[T comparable](t *testing.T, expected []PeriodValue[T], actual []PeriodValue[T]) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d items, got %d: %v", len(expected), len(actual), actual)
+	}
+
+	for i, e := range expected {
+		if !actual[i].Period.Equal(e.Period) || actual[i].Value != e.Value {
+			t.Errorf("Expected item %d to be %v, got %v", i, e, actual[i])
+		}
+	}
+}
+
+func TestTimeline_Intersect_ShouldKeepCommonPeriods(t *testing.T) {
+	contracts, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 2, 200).
+		Build()
+
+	bonuses, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 20), DateOnly(2024, 2, 10), 5).
+		AddMonth(2024, 4, 7).
+		Build()
+
+	result, err := contracts.Intersect(&bonuses, sum)
+	if err != nil {
+		t.Fatalf("Could not intersect: %s", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 2, 1)}, Value: 105},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 10)}, Value: 205},
+	}, result.Items)
+}
+
+func TestTimeline_Subtract_ShouldRemoveLeavePeriods(t *testing.T) {
+	contract, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 4, 1), 1).
+		Build()
+
+	leaves := []Period{
+		{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)},
+		{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 1)},
+		{Start: DateOnly(2024, 3, 25), End: DateOnly(2024, 4, 10)},
+	}
+
+	result, err := contract.SubtractPeriods(leaves, sum)
+	if err != nil {
+		t.Fatalf("Could not subtract: %s", err)
+	}
+
+	result = result.Optimize(func(a int, b int) bool { return a == b })
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 25)}, Value: 1},
+	}, result.Items)
+}
+
+func TestTimeline_Subtract_ShouldAggregateOwnValues(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddPeriod(DateOnly(2024, 1, 10), DateOnly(2024, 1, 20), 10).
+		Build()
+
+	other, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 15), DateOnly(2024, 2, 1), 1000).
+		Build()
+
+	result, err := timeline.Subtract(&other, sum)
+	if err != nil {
+		t.Fatalf("Could not subtract: %s", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, Value: 100},
+		{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 15)}, Value: 110},
+	}, result.Items)
+}
+
+func TestTimeline_Complement_ShouldReturnGaps(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 2, 200).
+		AddPeriod(DateOnly(2024, 2, 10), DateOnly(2024, 3, 10), 10).
+		AddMonth(2024, 5, 500).
+		Build()
+
+	year, _ := Year(2024)
+	result := timeline.Complement(*year, func(p Period) int { return -1 })
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, Value: -1},
+		{Period: Period{Start: DateOnly(2024, 3, 10), End: DateOnly(2024, 5, 1)}, Value: -1},
+		{Period: Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2025, 1, 1)}, Value: -1},
+	}, result.Items)
+}
@@ -0,0 +1,181 @@
+package timelines
+
+import (
+	"container/heap"
+	"errors"
+	"slices"
+	"time"
+)
+
+var errUnsortedPeriods = errors.New("timeline should have sorted periods")
+
+// sweep slices items pulled by next on all their boundaries, calling emit for each slice covered by at least one item.
+// Items must be pulled in chronological order of their start. emit receives covering items in pull order;
+// the slice is reused, so it must not be retained.
+func sweep[T any](next func() (PeriodValue[T], bool), emit func(period Period, active []PeriodValue[T])) error {
+	var active []PeriodValue[T]
+	ends := &endHeap{}
+	var cursor time.Time
+	started := false
+
+	pending, ok := next()
+	for ok || len(active) > 0 {
+		if len(active) == 0 {
+			if started && pending.Period.Start.Before(cursor) {
+				return errUnsortedPeriods
+			}
+			cursor = pending.Period.Start
+			started = true
+		}
+
+		// activate items starting at cursor
+		for ok && !pending.Period.Start.After(cursor) {
+			if pending.Period.Start.Before(cursor) {
+				return errUnsortedPeriods
+			}
+			active = append(active, pending)
+			heap.Push(ends, pending.Period.End)
+			pending, ok = next()
+		}
+
+		// next boundary is the first end of active items or the next start
+		boundary := (*ends)[0]
+		if ok {
+			boundary = minTime(boundary, pending.Period.Start)
+		}
+
+		if boundary.After(cursor) {
+			emit(Period{Start: cursor, End: boundary}, active)
+			cursor = boundary
+		}
+
+		// deactivate items ending at cursor
+		if (*ends)[0].After(cursor) {
+			continue
+		}
+		for ends.Len() > 0 && !(*ends)[0].After(cursor) {
+			heap.Pop(ends)
+		}
+		active = slices.DeleteFunc(active, func(pv PeriodValue[T]) bool {
+			return !pv.Period.End.After(cursor)
+		})
+	}
+
+	return nil
+}
+
+// endHeap is a min-heap of period ends.
+type endHeap []time.Time
+
+func (h endHeap) Len() int           { return len(h) }
+func (h endHeap) Less(i, j int) bool { return h[i].Before(h[j]) }
+func (h endHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *endHeap) Push(x any)        { *h = append(*h, x.(time.Time)) }
+
+func (h *endHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// foldSweep returns the slices of sorted items pulled by next, the value of each one being computed with seed
+// from its first covering item, then aggregated with f with next covering items in pull order.
+func foldSweep[T any](next func() (PeriodValue[T], bool), seed func(period Period, first T) T, f func(period Period, a T, b T) T) ([]PeriodValue[T], error) {
+	items := []PeriodValue[T]{}
+	err := sweep(next, func(period Period, active []PeriodValue[T]) {
+		value := seed(period, active[0].Value)
+		for _, candidate := range active[1:] {
+			value = f(period, candidate.Value, value)
+		}
+		items = append(items, NewPeriodValue(period, value))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// zeroSeed returns a seed aggregating the first value with a zero value, like ResolveConflicts does.
+func zeroSeed[T any](f func(period Period, a T, b T) T) func(period Period, first T) T {
+	return func(period Period, first T) T {
+		var zero T
+		return f(period, first, zero)
+	}
+}
+
+// pullSorted returns a function pulling sorted items one after the other.
+func pullSorted[T any](sorted []PeriodValue[T]) func() (PeriodValue[T], bool) {
+	i := 0
+	return func() (PeriodValue[T], bool) {
+		if i == len(sorted) {
+			return PeriodValue[T]{}, false
+		}
+		i++
+		return sorted[i-1], true
+	}
+}
+
+// mergeCursor is the position of the next item to pull from one of merged sources.
+type mergeCursor struct {
+	source int
+	index  int
+}
+
+// mergeHeap orders cursors by start of their next item, then by source.
+type mergeHeap[T any] struct {
+	sources [][]PeriodValue[T]
+	cursors []mergeCursor
+}
+
+func (h *mergeHeap[T]) item(c mergeCursor) PeriodValue[T] {
+	return h.sources[c.source][c.index]
+}
+
+func (h *mergeHeap[T]) Len() int { return len(h.cursors) }
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.item(h.cursors[i]).Period.Start, h.item(h.cursors[j]).Period.Start
+	if a.Equal(b) {
+		return h.cursors[i].source < h.cursors[j].source
+	}
+	return a.Before(b)
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *mergeHeap[T]) Push(x any) { h.cursors = append(h.cursors, x.(mergeCursor)) }
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
+
+// mergeSorted returns a function pulling items of all sorted sources in chronological order of their start,
+// items starting together being pulled in source order.
+func mergeSorted[T any](sources [][]PeriodValue[T]) func() (PeriodValue[T], bool) {
+	h := &mergeHeap[T]{sources: sources}
+	for source, items := range sources {
+		if len(items) > 0 {
+			h.cursors = append(h.cursors, mergeCursor{source: source})
+		}
+	}
+	heap.Init(h)
+
+	return func() (PeriodValue[T], bool) {
+		if h.Len() == 0 {
+			return PeriodValue[T]{}, false
+		}
+
+		c := h.cursors[0]
+		pv := h.item(c)
+		if c.index+1 < len(h.sources[c.source]) {
+			h.cursors[0].index++
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+		return pv, true
+	}
+}
@@ -0,0 +1,186 @@
+package timelines
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// naiveResolve aggregates values of items on every period between two consecutive boundaries.
+func naiveResolve(items []PeriodValue[int]) []PeriodValue[int] {
+	var boundaries []time.Time
+	for _, pv := range items {
+		boundaries = append(boundaries, pv.Period.Start, pv.Period.End)
+	}
+	slices.SortFunc(boundaries, time.Time.Compare)
+	boundaries = slices.CompactFunc(boundaries, time.Time.Equal)
+
+	var result []PeriodValue[int]
+	for i := 1; i < len(boundaries); i++ {
+		period := Period{Start: boundaries[i-1], End: boundaries[i]}
+		covered := false
+		value := 0
+		for _, pv := range items {
+			if pv.Period.Intersects(period) {
+				covered = true
+				value += pv.Value
+			}
+		}
+		if covered {
+			result = append(result, NewPeriodValue(period, value))
+		}
+	}
+	return result
+}
+
+func TestResolveConflicts_ShouldMatchNaiveSlicing(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for round := 0; round < 20; round++ {
+		var timelines []*Timeline[int]
+		concat := NewTimeline[int]()
+		for i := 0; i < 1+r.Intn(6); i++ {
+			timeline := randomTimeline(r, r.Intn(30), 30*24*time.Hour)
+			timelines = append(timelines, &timeline)
+			concat.Items = append(concat.Items, timeline.Items...)
+		}
+		concat.SortTimelineByPeriodStart()
+		expected := naiveResolve(concat.Items)
+
+		resolved, err := concat.ResolveConflicts(sum)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		assertPeriodValues(t, expected, resolved.Items)
+
+		aggregated, err := AggregateAll(timelines, sum)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		assertPeriodValues(t, expected, aggregated.Items)
+	}
+}
+
+func TestTimeline_ResolveConflictsWithSeed_ShouldSeedFirstValue(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 10), 4).
+		AddPeriod(DateOnly(2024, 1, 5), DateOnly(2024, 1, 15), 3).
+		Build()
+
+	// product of values, which would always be 0 starting from a zero value
+	result, err := timeline.ResolveConflictsWithSeed(
+		func(p Period, first int) int { return first },
+		func(p Period, a int, b int) int { return a * b },
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 5)}, Value: 4},
+		{Period: Period{Start: DateOnly(2024, 1, 5), End: DateOnly(2024, 1, 10)}, Value: 12},
+		{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 15)}, Value: 3},
+	}, result.Items)
+}
+
+func TestAggregateAll_ShouldAggregateInStartOrder(t *testing.T) {
+	january, _ := Month(2024, 1)
+	a := NewTimeline[string]()
+	a.Add(*january, "a")
+	b := NewTimeline[string]()
+	b.Add(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 2, 10)}, "b")
+	c := NewTimeline[string]()
+	c.Add(*january, "c")
+
+	result, err := AggregateAll([]*Timeline[string]{&a, &b, &c}, func(p Period, value string, acc string) string {
+		return acc + value
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[string]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, Value: "ac"},
+		{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 2, 1)}, Value: "acb"},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 10)}, Value: "b"},
+	}, result.Items)
+}
+
+func TestAggregateAll_ShouldSortUnsortedTimeline(t *testing.T) {
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+	unsorted := Timeline[int]{Items: []PeriodValue[int]{NewPeriodValue(*february, 1), NewPeriodValue(*january, 1)}}
+
+	result, err := AggregateAll([]*Timeline[int]{&unsorted}, sum)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{NewPeriodValue(*january, 1), NewPeriodValue(*february, 1)}, result.Items)
+}
+
+func TestTimeline_Aggregate_ShouldNotModifyReceiver(t *testing.T) {
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	timeline := Timeline[int]{Items: make([]PeriodValue[int], 0, 10)}
+	timeline.Add(*february, 2)
+	other := NewTimeline[int]()
+	other.Add(*january, 1)
+
+	if _, err := timeline.Aggregate(&other, sum); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(timeline.Items) != 1 || timeline.Items[0].Value != 2 || timeline.Items[:2][1].Value != 0 {
+		t.Errorf("Expected receiver to be unchanged, got %v", timeline.Items[:2])
+	}
+}
+
+func BenchmarkAggregateAll(b *testing.B) {
+	// 500 employees having 50 successive contracts each
+	r := rand.New(rand.NewSource(1))
+	timelines := make([]*Timeline[int], 500)
+	for i := range timelines {
+		timeline := NewTimeline[int]()
+		cursor := DateOnly(2024, 1, 1)
+		for j := 0; j < 50; j++ {
+			end := cursor.Add(time.Hour + time.Duration(r.Int63n(int64(14*24*time.Hour))))
+			timeline.Items = append(timeline.Items, NewPeriodValue(Period{Start: cursor, End: end}, j))
+			cursor = end
+		}
+		timelines[i] = &timeline
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := AggregateAll(timelines, sum); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTimeline_ResolveConflicts(b *testing.B) {
+	// 10^5 items lasting up to a week within a year, so about a thousand overlapping at any instant
+	timeline := randomTimeline(rand.New(rand.NewSource(1)), 100_000, 7*24*time.Hour)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := timeline.ResolveConflicts(sum); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTimeline_ResolveConflicts_Sparse(b *testing.B) {
+	// 10^5 items lasting up to an hour within a year, so rarely overlapping
+	timeline := randomTimeline(rand.New(rand.NewSource(1)), 100_000, time.Hour)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := timeline.ResolveConflicts(sum); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
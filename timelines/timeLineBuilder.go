@@ -7,13 +7,27 @@ import (
 
 // TimeLineBuilder permet de construire une Timeline de manière fluide.
 type TimeLineBuilder[T comparable] struct {
-	items []PeriodValue[T]
-	err   error
+	items    []PeriodValue[T]
+	location *time.Location
+	strategy ConflictStrategy[T]
+	err      error
 }
 
 // NewTimeLineBuilder crée une nouvelle instance de TimeLineBuilder.
 func NewTimeLineBuilder[T comparable]() *TimeLineBuilder[T] {
-	return &TimeLineBuilder[T]{items: []PeriodValue[T]{}}
+	return &TimeLineBuilder[T]{items: []PeriodValue[T]{}, location: time.UTC}
+}
+
+// WithLocation sets the location used by AddMonth and AddDay to compute local midnights, UTC if loc is nil.
+func (b *TimeLineBuilder[T]) WithLocation(loc *time.Location) *TimeLineBuilder[T] {
+	b.location = locationOrUTC(loc)
+	return b
+}
+
+// WithConflictStrategy makes Build resolve overlaps with strategy, periods being considered inserted in the order they were added.
+func (b *TimeLineBuilder[T]) WithConflictStrategy(strategy ConflictStrategy[T]) *TimeLineBuilder[T] {
+	b.strategy = strategy
+	return b
 }
 
 // AddPeriod ajoute une période avec une valeur à la timeline.
@@ -50,7 +64,7 @@ func (b *TimeLineBuilder[T]) AddPeriodValue(pv PeriodValue[T]) *TimeLineBuilder[
 
 // AddMonth adds a period corresponding to a given month with a value.
 func (b *TimeLineBuilder[T]) AddMonth(year int, month int, value T) *TimeLineBuilder[T] {
-	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	start := DateOnlyIn(year, month, 1, b.location)
 	end := start.AddDate(0, 1, 0)
 
 	return b.AddPeriod(start, end, value)
@@ -58,20 +72,55 @@ func (b *TimeLineBuilder[T]) AddMonth(year int, month int, value T) *TimeLineBui
 
 // AddDay adds a period corresponding to a given day with a value.
 func (b *TimeLineBuilder[T]) AddDay(year int, month int, day int, value T) *TimeLineBuilder[T] {
-	start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	start := DateOnlyIn(year, month, day, b.location)
 	end := start.AddDate(0, 0, 1)
 
 	return b.AddPeriod(start, end, value)
 }
 
+// AddRecurrence adds a period with a value for each occurrence of the recurrence starting within given period.
+func (b *TimeLineBuilder[T]) AddRecurrence(r *Recurrence, within Period, value T) *TimeLineBuilder[T] {
+	if b.err != nil {
+		return b
+	}
+
+	items, err := RecurringPeriodValues(r, within, func(period Period) T { return value })
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.items = append(b.items, items...)
+	return b
+}
+
 // Build builds the Timeline by sorting the periods in chronological order.
+// With a conflict strategy, overlaps are resolved so that the Timeline has no overlaps.
 func (b *TimeLineBuilder[T]) Build() (Timeline[T], error) {
 	if b.err != nil {
 		return Timeline[T]{}, b.err
 	}
 
+	if b.strategy != nil {
+		items, err := resolveWithStrategy(b.items, b.strategy)
+		if err != nil {
+			return Timeline[T]{}, err
+		}
+		return Timeline[T]{Items: items}, nil
+	}
+
 	t := Timeline[T]{Items: b.items}
 	t.SortTimelineByPeriodStart()
 
 	return t, nil
 }
+
+// BuildIndexed builds an IndexedTimeline from added periods.
+func (b *TimeLineBuilder[T]) BuildIndexed() (*IndexedTimeline[T], error) {
+	t, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndexedTimeline(t), nil
+}
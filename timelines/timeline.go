@@ -1,7 +1,7 @@
 package timelines
 
 import (
-	"errors"
+	"slices"
 	"sort"
 )
 
@@ -19,11 +19,20 @@ func NewTimeline[T any]() Timeline[T] {
 
 // SortTimelineByPeriodStart sorts the Timeline items by the Start date of their Periods
 func (t *Timeline[T]) SortTimelineByPeriodStart() {
-	sort.Slice(t.Items, func(i, j int) bool {
-		return t.Items[i].Period.Start.Before(t.Items[j].Period.Start)
+	sortByPeriodStart(t.Items)
+}
+
+func sortByPeriodStart[T any](items []PeriodValue[T]) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Period.Start.Before(items[j].Period.Start)
 	})
 }
 
+// comparePeriodStarts orders items by the Start date of their Periods.
+func comparePeriodStarts[T any](a PeriodValue[T], b PeriodValue[T]) int {
+	return a.Period.Start.Compare(b.Period.Start)
+}
+
 func (t *Timeline[T]) FindIntersects(period Period) []PeriodValue[T] {
 	var items []PeriodValue[T]
 
@@ -59,69 +68,33 @@ func (t *Timeline[T]) GetAll() []PeriodValue[T] {
 	return t.Items
 }
 
-func computeValuesOnSamePeriods[T any](buffer []PeriodValue[T], f func(p Period, a T, b T) T) []PeriodValue[T] {
-	var items []PeriodValue[T]
-	periods := SplitAllPeriods(buffer)
-
-	for _, period := range periods {
-		var currentValue T
-
-		for _, candidate := range buffer {
-			if candidate.Period.Intersects(period) {
-				currentValue = f(period, candidate.Value, currentValue)
-			}
-		}
-
-		items = append(items, NewPeriodValue(period, currentValue))
-	}
-
-	return items
-}
-
 // ResolveConflicts returns another Timeline having all values with same period aggregated, slicing them if necessary.
+// For each sliced period, f receives every covering value in order with the aggregate so far, starting from a zero value.
 func (t *Timeline[T]) ResolveConflicts(f func(p Period, a T, b T) T) (Timeline[T], error) {
-	var items []PeriodValue[T]
-	var buffer []PeriodValue[T]
-	var currentPeriod Period
-
-	for i, next := range t.Items {
-		if i == 0 {
-			currentPeriod = next.Period
-			buffer = append(buffer, next)
-			continue
-		}
-
-		// We assume that periods are chronologically sorted
-		if next.Period.Before(currentPeriod) {
-			return Timeline[T]{}, errors.New("timeline should have sorted periods")
-		}
-
-		if next.Period.After(currentPeriod) {
-			computed := computeValuesOnSamePeriods(buffer, f)
-			items = append(items, computed...)
-			currentPeriod = next.Period
-
-			buffer = ClampPeriods(buffer, currentPeriod)
-			buffer = append(buffer, next)
+	items, err := resolveConflicts(t.Items, f)
+	if err != nil {
+		return Timeline[T]{}, err
+	}
 
-			continue
-		}
+	return Timeline[T]{Items: items}, nil
+}
 
-		period, err := NewPeriod(currentPeriod.Start, maxTime(next.Period.Start, currentPeriod.End))
-		if err != nil {
-			return Timeline[T]{}, err
-		}
-		currentPeriod = *period
-		buffer = append(buffer, next)
+// ResolveConflictsWithSeed is ResolveConflicts where the first value covering each sliced period is converted by seed,
+// next ones being aggregated with f, instead of aggregating the first one with a zero value.
+func (t *Timeline[T]) ResolveConflictsWithSeed(seed func(p Period, first T) T, f func(p Period, a T, b T) T) (Timeline[T], error) {
+	items, err := foldSweep(pullSorted(t.Items), seed, f)
+	if err != nil {
+		return Timeline[T]{}, err
 	}
 
-	computed := computeValuesOnSamePeriods(buffer, f)
-	items = append(items, computed...)
-	buffer = make([]PeriodValue[T], 0)
-
 	return Timeline[T]{Items: items}, nil
 }
 
+// resolveConflicts aggregates values of sorted items having same period, slicing them if necessary.
+func resolveConflicts[T any](sorted []PeriodValue[T], f func(p Period, a T, b T) T) ([]PeriodValue[T], error) {
+	return foldSweep(pullSorted(sorted), zeroSeed(f), f)
+}
+
 // Optimize merges all contiguous periods having same value
 func (t *Timeline[T]) Optimize(equalityComparer func(a T, b T) bool) Timeline[T] {
 	var previous PeriodValue[T]
@@ -161,14 +134,27 @@ func (t *Timeline[T]) Aggregate(other *Timeline[T], f func(period Period, a T, b
 		return *t, nil
 	}
 
-	concat := Timeline[T]{
-		Items: append(t.Items, other.Items...),
+	return AggregateAll([]*Timeline[T]{t, other}, f)
+}
+
+// AggregateAll aggregates all timelines at once and returns another timeline, slicing periods like ResolveConflicts does.
+// Values covering a same period are aggregated in order of their start, then of their timeline.
+// Unsorted timelines are sorted on a copy, leaving them unchanged.
+func AggregateAll[T any](timelines []*Timeline[T], f func(period Period, a T, b T) T) (Timeline[T], error) {
+	sources := make([][]PeriodValue[T], 0, len(timelines))
+	for _, t := range timelines {
+		items := t.Items
+		if !slices.IsSortedFunc(items, comparePeriodStarts) {
+			items = slices.Clone(items)
+			sortStableByPeriodStart(items)
+		}
+		sources = append(sources, items)
 	}
-	concat.SortTimelineByPeriodStart()
-	result, err := concat.ResolveConflicts(f)
+
+	items, err := foldSweep(mergeSorted(sources), zeroSeed(f), f)
 	if err != nil {
 		return Timeline[T]{}, err
 	}
 
-	return result, nil
+	return Timeline[T]{Items: items}, nil
 }
@@ -731,3 +731,121 @@ func TestTimeline_Aggregate_ShouldReturnAdditions(t *testing.T) {
 		}
 	}
 }
+
+func TestTimeLineBuilder_WithLocation_ShouldUseLocalMidnight(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Could not load location: %v", err)
+	}
+
+	timeline, err := NewTimeLineBuilder[int]().
+		WithLocation(paris).
+		AddMonth(2024, 3, 100).
+		AddDay(2024, 10, 27, 50).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	march, _ := MonthIn(2024, 3, paris)
+	if !timeline.Items[0].Period.Equal(*march) {
+		t.Errorf("Expected period to be %v, got %v", *march, timeline.Items[0].Period)
+	}
+
+	if !timeline.Items[0].Period.Start.Equal(time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected march to start at 23:00 UTC, got %v", timeline.Items[0].Period.Start.UTC())
+	}
+
+	if timeline.Items[1].Period.Duration() != 25*time.Hour {
+		t.Errorf("Expected 25h on DST day, got %v", timeline.Items[1].Period.Duration())
+	}
+}
+
+func TestTimeLineBuilder_WithLocation_ShouldDefaultNilToUTC(t *testing.T) {
+	timeline, err := NewTimeLineBuilder[int]().
+		WithLocation(nil).
+		AddMonth(2024, 3, 100).
+		AddDay(2024, 10, 27, 50).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	march, _ := Month(2024, 3)
+	if !timeline.Items[0].Period.Equal(*march) {
+		t.Errorf("Expected period to be %v, got %v", *march, timeline.Items[0].Period)
+	}
+	if timeline.Items[1].Period.Duration() != 24*time.Hour {
+		t.Errorf("Expected 24h day, got %v", timeline.Items[1].Period.Duration())
+	}
+}
+
+func TestTimeline_ResolveConflicts_ShouldHandleInfinitePeriods(t *testing.T) {
+	history, _ := NewPeriodUntil(DateOnly(2024, 2, 1))
+	contract, _ := NewPeriodFrom(DateOnly(2024, 1, 1))
+
+	timeline := NewTimeline[int]()
+	timeline.Add(*contract, 2)
+	timeline.Add(*history, 1)
+
+	result, err := timeline.ResolveConflicts(func(p Period, a int, b int) int { return a + b })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []PeriodValue[int]{
+		{Period: Period{Start: NegativeInfinity, End: DateOnly(2024, 1, 1)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, Value: 3},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: PositiveInfinity}, Value: 2},
+	}
+
+	if len(result.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(result.Items))
+	}
+
+	for i, e := range expected {
+		if !result.Items[i].Period.Equal(e.Period) || result.Items[i].Value != e.Value {
+			t.Errorf("Expected %v, got %v", e, result.Items[i])
+		}
+	}
+}
+
+func TestTimeline_ResolveConflicts_ShouldExtendOverlappingChain(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 10), 1).
+		AddPeriod(DateOnly(2024, 1, 5), DateOnly(2024, 1, 20), 10).
+		AddPeriod(DateOnly(2024, 1, 15), DateOnly(2024, 1, 25), 100).
+		Build()
+
+	result, err := timeline.ResolveConflicts(sum)
+	if err != nil {
+		t.Fatalf("Could not resolve: %s", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 5)}, Value: 1},
+		{Period: Period{Start: DateOnly(2024, 1, 5), End: DateOnly(2024, 1, 10)}, Value: 11},
+		{Period: Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 15)}, Value: 10},
+		{Period: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 1, 20)}, Value: 110},
+		{Period: Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 25)}, Value: 100},
+	}, result.Items)
+}
+
+func TestTimeline_Aggregate_ShouldSortUnsortedTimelines(t *testing.T) {
+	january, _ := Month(2024, 1)
+	february, _ := Month(2024, 2)
+
+	timeline := Timeline[int]{Items: []PeriodValue[int]{NewPeriodValue(*february, 1), NewPeriodValue(*january, 2)}}
+	other := Timeline[int]{Items: []PeriodValue[int]{NewPeriodValue(*january, 10)}}
+
+	result, err := timeline.Aggregate(&other, func(period Period, a int, b int) int { return a + b })
+	if err != nil {
+		t.Fatalf("Could not aggregate: %s", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{NewPeriodValue(*january, 12), NewPeriodValue(*february, 1)}, result.Items)
+
+	if !timeline.Items[0].Period.Equal(*february) {
+		t.Errorf("Expected receiver to be unchanged, got %v", timeline.Items)
+	}
+}
@@ -0,0 +1,68 @@
+package timelines
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Map returns a Timeline having the same periods, with values converted by f.
+func Map[T any, U any](t Timeline[T], f func(period Period, value T) U) Timeline[U] {
+	items := make([]PeriodValue[U], 0, len(t.Items))
+	for _, pv := range t.Items {
+		items = append(items, NewPeriodValue(pv.Period, f(pv.Period, pv.Value)))
+	}
+	return Timeline[U]{Items: items}
+}
+
+// Filter returns a Timeline keeping only items for which keep returns true, in the same order.
+func Filter[T any](t Timeline[T], keep func(period Period, value T) bool) Timeline[T] {
+	items := []PeriodValue[T]{}
+	for _, pv := range t.Items {
+		if keep(pv.Period, pv.Value) {
+			items = append(items, pv)
+		}
+	}
+	return Timeline[T]{Items: items}
+}
+
+// MapPeriod returns a Timeline having periods converted by f, for instance to shift or stretch them,
+// sorted by their new Start date. Items whose period becomes empty are removed.
+// It returns an error if f returns a period ending before it starts.
+func MapPeriod[T any](t Timeline[T], f func(period Period) Period) (Timeline[T], error) {
+	items := make([]PeriodValue[T], 0, len(t.Items))
+	for _, pv := range t.Items {
+		mapped := f(pv.Period)
+		if mapped.End.Before(mapped.Start) {
+			return Timeline[T]{}, fmt.Errorf("mapped period %v ends before it starts", mapped)
+		}
+		if !mapped.IsEmpty() {
+			items = append(items, NewPeriodValue(mapped, pv.Value))
+		}
+	}
+
+	sortStableByPeriodStart(items)
+	return Timeline[T]{Items: items}, nil
+}
+
+// FlatMap returns a Timeline made of all items returned by f for each item, sorted by the Start date of their Periods.
+// Items having an empty period are removed.
+func FlatMap[T any, U any](t Timeline[T], f func(period Period, value T) []PeriodValue[U]) Timeline[U] {
+	items := []PeriodValue[U]{}
+	for _, pv := range t.Items {
+		for _, mapped := range f(pv.Period, pv.Value) {
+			if !mapped.IsEmpty() {
+				items = append(items, mapped)
+			}
+		}
+	}
+
+	sortStableByPeriodStart(items)
+	return Timeline[U]{Items: items}
+}
+
+// sortStableByPeriodStart sorts items by the Start date of their Periods, keeping the order of items starting together.
+func sortStableByPeriodStart[T any](items []PeriodValue[T]) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Period.Start.Before(items[j].Period.Start)
+	})
+}
@@ -0,0 +1,124 @@
+package timelines
+
+import (
+	"testing"
+)
+
+type contractTerms struct {
+	name  string
+	daily float64
+}
+
+func TestMap_ShouldConvertValues(t *testing.T) {
+	contracts, _ := NewTimeLineBuilder[contractTerms]().
+		AddMonth(2024, 1, contractTerms{name: "junior", daily: 100}).
+		AddMonth(2024, 2, contractTerms{name: "senior", daily: 150}).
+		Build()
+
+	costs := Map(contracts, func(period Period, c contractTerms) float64 {
+		return c.daily * period.Duration().Hours() / 24
+	})
+
+	assertPeriodValues(t, []PeriodValue[float64]{
+		{Period: contracts.Items[0].Period, Value: 3100},
+		{Period: contracts.Items[1].Period, Value: 4350},
+	}, costs.Items)
+}
+
+func TestFilter_ShouldKeepOrder(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 2, 2).
+		AddMonth(2024, 3, 3).
+		Build()
+
+	odd := Filter(timeline, func(period Period, value int) bool { return value%2 == 1 })
+
+	assertPeriodValues(t, []PeriodValue[int]{timeline.Items[0], timeline.Items[2]}, odd.Items)
+}
+
+func TestMapPeriod_ShouldShiftAndSort(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddDay(2024, 1, 1, 1).
+		AddDay(2024, 1, 2, 2).
+		Build()
+
+	// stretch first day to 3 days and shift the second one backward
+	mapped, err := MapPeriod(timeline, func(period Period) Period {
+		if period.Start.Equal(DateOnly(2024, 1, 1)) {
+			return Period{Start: period.Start, End: period.End.AddDate(0, 0, 2)}
+		}
+		return Period{Start: period.Start.AddDate(0, 0, -5), End: period.End.AddDate(0, 0, -5)}
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2023, 12, 28), End: DateOnly(2023, 12, 29)}, Value: 2},
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 4)}, Value: 1},
+	}, mapped.Items)
+
+	_, err = MapPeriod(timeline, func(period Period) Period {
+		return Period{Start: period.End, End: period.Start}
+	})
+	if err == nil {
+		t.Errorf("Expected an error for a reversed period")
+	}
+}
+
+func TestMapPeriod_ShouldRemoveEmptyPeriods(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddDay(2024, 1, 1, 1).
+		AddDay(2024, 1, 2, 2).
+		Build()
+
+	mapped, err := MapPeriod(timeline, func(period Period) Period {
+		if period.Start.Equal(DateOnly(2024, 1, 1)) {
+			return Period{Start: period.Start, End: period.Start}
+		}
+		return period
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[int]{timeline.Items[1]}, mapped.Items)
+}
+
+func TestFlatMap_ShouldSplitItemsAndOptimizeBack(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 4), 7).
+		AddPeriod(DateOnly(2024, 1, 2), DateOnly(2024, 1, 3), 8).
+		Build()
+
+	daily := FlatMap(timeline, func(period Period, value int) []PeriodValue[int] {
+		var items []PeriodValue[int]
+		for day := range period.SplitByDaysSeq() {
+			items = append(items, NewPeriodValue(day, value))
+		}
+		return items
+	})
+
+	if len(daily.Items) != 4 {
+		t.Fatalf("Expected 4 items, got %d", len(daily.Items))
+	}
+	for i := 1; i < len(daily.Items); i++ {
+		if daily.Items[i].Period.Start.Before(daily.Items[i-1].Period.Start) {
+			t.Errorf("Expected items to be sorted, got %v", daily.Items)
+		}
+	}
+
+	sevens := Filter(daily, func(period Period, value int) bool { return value == 7 })
+	optimized := sevens.Optimize(func(a int, b int) bool { return a == b })
+	assertPeriodValues(t, []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 4)}, Value: 7},
+	}, optimized.Items)
+
+	empty := FlatMap(timeline, func(period Period, value int) []PeriodValue[string] {
+		return []PeriodValue[string]{NewPeriodValue(Period{Start: period.Start, End: period.Start}, "")}
+	})
+	if len(empty.Items) != 0 {
+		t.Errorf("Expected empty periods to be removed, got %v", empty.Items)
+	}
+}
@@ -0,0 +1,73 @@
+package timelines
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"time"
+)
+
+// searchAfter returns the index of the first item starting after given instant, assuming items are sorted.
+func (t *Timeline[T]) searchAfter(instant time.Time) int {
+	return sort.Search(len(t.Items), func(i int) bool {
+		return t.Items[i].Period.Start.After(instant)
+	})
+}
+
+// ValueAt returns the value of the item covering given instant, using a binary search.
+// A period covers its start but not its end, so on a boundary the value of the starting period is returned.
+// Timeline items must be sorted and must not overlap: use ValuesAt otherwise.
+func (t *Timeline[T]) ValueAt(instant time.Time) (T, bool) {
+	i := t.searchAfter(instant) - 1
+	if i >= 0 && t.Items[i].Period.End.After(instant) {
+		return t.Items[i].Value, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// ValuesAt returns values of all items covering given instant, ordered by period start.
+// Timeline items must be sorted, but may overlap. A binary search skips items starting after instant,
+// but items starting before it are scanned, so the cost is linear for late instants:
+// use IndexedTimeline.FindAt for repeated lookups, or ValuesAtSorted for many instants.
+func (t *Timeline[T]) ValuesAt(instant time.Time) []T {
+	var values []T
+
+	for _, pv := range t.Items[:t.searchAfter(instant)] {
+		if pv.Period.End.After(instant) {
+			values = append(values, pv.Value)
+		}
+	}
+
+	return values
+}
+
+// ValuesAtSorted returns for each of given chronologically sorted instants the values covering it,
+// as ValuesAt does, in a single pass over the timeline. Timeline items must be sorted.
+func (t *Timeline[T]) ValuesAtSorted(instants []time.Time) ([][]T, error) {
+	results := make([][]T, len(instants))
+	var active []PeriodValue[T]
+	next := 0
+
+	for i, instant := range instants {
+		if i > 0 && instant.Before(instants[i-1]) {
+			return nil, errors.New("instants should be sorted")
+		}
+
+		for next < len(t.Items) && !t.Items[next].Period.Start.After(instant) {
+			active = append(active, t.Items[next])
+			next++
+		}
+
+		active = slices.DeleteFunc(active, func(pv PeriodValue[T]) bool {
+			return !pv.Period.End.After(instant)
+		})
+
+		for _, pv := range active {
+			results[i] = append(results[i], pv.Value)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,87 @@
+package timelines
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTimeline_ValueAt(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 2, 200).
+		AddMonth(2024, 4, 400).
+		Build()
+
+	tests := []struct {
+		name     string
+		instant  time.Time
+		expected int
+		found    bool
+	}{
+		{name: "inside", instant: time.Date(2024, 2, 14, 10, 0, 0, 0, time.UTC), expected: 200, found: true},
+		{name: "on first start", instant: DateOnly(2024, 1, 1), expected: 100, found: true},
+		{name: "on boundary", instant: DateOnly(2024, 2, 1), expected: 200, found: true},
+		{name: "on end before gap", instant: DateOnly(2024, 3, 1), found: false},
+		{name: "in gap", instant: DateOnly(2024, 3, 15), found: false},
+		{name: "before all", instant: DateOnly(2023, 12, 31), found: false},
+		{name: "on last end", instant: DateOnly(2024, 5, 1), found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found := timeline.ValueAt(tt.instant)
+			if found != tt.found || value != tt.expected {
+				t.Errorf("Expected (%v, %v), got (%v, %v)", tt.expected, tt.found, value, found)
+			}
+		})
+	}
+}
+
+func TestTimeline_ValuesAt_ShouldReturnOverlappingValues(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 12, 31), 1).
+		AddMonth(2024, 2, 2).
+		AddDay(2024, 2, 14, 3).
+		Build()
+
+	values := timeline.ValuesAt(time.Date(2024, 2, 14, 10, 0, 0, 0, time.UTC))
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", values)
+	}
+
+	values = timeline.ValuesAt(DateOnly(2024, 3, 1))
+	if !slices.Equal(values, []int{1}) {
+		t.Errorf("Expected [1], got %v", values)
+	}
+}
+
+func TestTimeline_ValuesAtSorted_ShouldMatchValuesAt(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	timeline := randomTimeline(r, 500, 20*24*time.Hour)
+
+	var instants []time.Time
+	for _, pv := range randomTimeline(r, 300, time.Hour).Items {
+		instants = append(instants, pv.Period.Start)
+	}
+	// boundaries must be handled the same way
+	instants = append(instants, timeline.Items[10].Period.Start, timeline.Items[20].Period.End)
+	slices.SortFunc(instants, time.Time.Compare)
+
+	results, err := timeline.ValuesAtSorted(instants)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for i, instant := range instants {
+		expected := timeline.ValuesAt(instant)
+		if !slices.Equal(results[i], expected) {
+			t.Errorf("At %v: expected %v, got %v", instant, expected, results[i])
+		}
+	}
+
+	if _, err := timeline.ValuesAtSorted([]time.Time{DateOnly(2024, 2, 1), DateOnly(2024, 1, 1)}); err == nil {
+		t.Errorf("Expected an error for unsorted instants")
+	}
+}
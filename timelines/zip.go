@@ -0,0 +1,75 @@
+package timelines
+
+import (
+	"errors"
+)
+
+// zipped holds the value of either side of a zip.
+type zipped[A any, B any] struct {
+	a *A
+	b *B
+}
+
+// zip slices both timelines on all their boundaries, calling f for each slice kept by keep.
+func zip[A any, B any, C any](a Timeline[A], b Timeline[B], keep func(a *A, b *B) bool, f func(period Period, a *A, b *B) C) (Timeline[C], error) {
+	left := make([]PeriodValue[zipped[A, B]], 0, len(a.Items))
+	for _, pv := range a.Items {
+		value := pv.Value
+		left = append(left, NewPeriodValue(pv.Period, zipped[A, B]{a: &value}))
+	}
+	right := make([]PeriodValue[zipped[A, B]], 0, len(b.Items))
+	for _, pv := range b.Items {
+		value := pv.Value
+		right = append(right, NewPeriodValue(pv.Period, zipped[A, B]{b: &value}))
+	}
+
+	result := NewTimeline[C]()
+	var overlapping error
+	err := sweep(mergeSorted([][]PeriodValue[zipped[A, B]]{left, right}), func(period Period, active []PeriodValue[zipped[A, B]]) {
+		var current zipped[A, B]
+		for _, pv := range active {
+			if (pv.Value.a != nil && current.a != nil) || (pv.Value.b != nil && current.b != nil) {
+				overlapping = errors.New("zipped timelines should not have overlapping periods")
+				return
+			}
+			if pv.Value.a != nil {
+				current.a = pv.Value.a
+			} else {
+				current.b = pv.Value.b
+			}
+		}
+
+		if keep(current.a, current.b) {
+			result.Items = append(result.Items, NewPeriodValue(period, f(period, current.a, current.b)))
+		}
+	})
+	if err == nil {
+		err = overlapping
+	}
+	if err != nil {
+		return Timeline[C]{}, err
+	}
+
+	return result, nil
+}
+
+// Zip combines two timelines of different types, slicing periods on all their boundaries (full outer join).
+// For each slice, f receives the values of both timelines, nil meaning the timeline does not cover the slice.
+// Timeline items must be sorted and must not overlap.
+func Zip[A any, B any, C any](a Timeline[A], b Timeline[B], f func(period Period, a *A, b *B) C) (Timeline[C], error) {
+	return zip(a, b, func(a *A, b *B) bool { return true }, f)
+}
+
+// ZipInner is Zip keeping only slices covered by both timelines (inner join).
+func ZipInner[A any, B any, C any](a Timeline[A], b Timeline[B], f func(period Period, a A, b B) C) (Timeline[C], error) {
+	return zip(a, b, func(a *A, b *B) bool { return a != nil && b != nil }, func(period Period, a *A, b *B) C {
+		return f(period, *a, *b)
+	})
+}
+
+// ZipLeft is Zip keeping only slices covered by the first timeline (left join).
+func ZipLeft[A any, B any, C any](a Timeline[A], b Timeline[B], f func(period Period, a A, b *B) C) (Timeline[C], error) {
+	return zip(a, b, func(a *A, b *B) bool { return a != nil }, func(period Period, a *A, b *B) C {
+		return f(period, *a, b)
+	})
+}
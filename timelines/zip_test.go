@@ -0,0 +1,108 @@
+package timelines
+
+import (
+	"testing"
+)
+
+type rate struct {
+	daily float64
+}
+
+type quantity struct {
+	days int
+}
+
+func zipFixtures(t *testing.T) (Timeline[rate], Timeline[quantity]) {
+	t.Helper()
+
+	rates, err := NewTimeLineBuilder[rate]().
+		AddMonth(2024, 1, rate{daily: 100}).
+		AddMonth(2024, 2, rate{daily: 120}).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	quantities, err := NewTimeLineBuilder[quantity]().
+		AddPeriod(DateOnly(2024, 1, 15), DateOnly(2024, 2, 15), quantity{days: 3}).
+		AddMonth(2024, 4, quantity{days: 1}).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	return rates, quantities
+}
+
+func TestZip_ShouldTellMissingSides(t *testing.T) {
+	rates, quantities := zipFixtures(t)
+
+	result, err := Zip(rates, quantities, func(period Period, r *rate, q *quantity) string {
+		switch {
+		case r == nil:
+			return "right"
+		case q == nil:
+			return "left"
+		default:
+			return "both"
+		}
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[string]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 15)}, Value: "left"},
+		{Period: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 1)}, Value: "both"},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 15)}, Value: "both"},
+		{Period: Period{Start: DateOnly(2024, 2, 15), End: DateOnly(2024, 3, 1)}, Value: "left"},
+		{Period: Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 5, 1)}, Value: "right"},
+	}, result.Items)
+}
+
+func TestZipInner_ShouldKeepCommonSlices(t *testing.T) {
+	rates, quantities := zipFixtures(t)
+
+	costs, err := ZipInner(rates, quantities, func(period Period, r rate, q quantity) float64 {
+		return r.daily * float64(q.days)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[float64]{
+		{Period: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 1)}, Value: 300},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 15)}, Value: 360},
+	}, costs.Items)
+}
+
+func TestZipLeft_ShouldKeepLeftSlices(t *testing.T) {
+	rates, quantities := zipFixtures(t)
+
+	costs, err := ZipLeft(rates, quantities, func(period Period, r rate, q *quantity) float64 {
+		if q == nil {
+			return 0
+		}
+		return r.daily * float64(q.days)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertPeriodValues(t, []PeriodValue[float64]{
+		{Period: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 15)}, Value: 0},
+		{Period: Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 1)}, Value: 300},
+		{Period: Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 15)}, Value: 360},
+		{Period: Period{Start: DateOnly(2024, 2, 15), End: DateOnly(2024, 3, 1)}, Value: 0},
+	}, costs.Items)
+}
+
+func TestZip_ShouldRejectOverlappingItems(t *testing.T) {
+	rates, quantities := zipFixtures(t)
+	rates.Add(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 20)}, rate{daily: 1})
+
+	_, err := Zip(rates, quantities, func(period Period, r *rate, q *quantity) int { return 0 })
+	if err == nil {
+		t.Errorf("Expected an error for overlapping items")
+	}
+}